@@ -0,0 +1,92 @@
+package address
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/mr-tron/base58"
+)
+
+const (
+	MainNetVersion byte = 0x1c
+	TestNetVersion byte = 0x41
+	ChecksumSize        = 4
+)
+
+var (
+	ErrInvalidAddress  = errors.New("address is malformed")
+	ErrInvalidChecksum = errors.New("address checksum doesn't match")
+	ErrUnknownVersion  = errors.New("address version is unknown")
+	ErrWrongNetwork    = errors.New("address belongs to another network")
+)
+
+type Address struct {
+	Version   byte
+	PublicKey ed25519.PublicKey
+}
+
+func checksum(payload []byte) []byte {
+	h := sha256.Sum256(payload)
+	h = sha256.Sum256(h[:])
+	return h[:ChecksumSize]
+}
+
+func NewAddress(version byte, pub ed25519.PublicKey) Address {
+	return Address{Version: version, PublicKey: pub}
+}
+
+func (a Address) String() string {
+	payload := make([]byte, 0, 1+len(a.PublicKey)+ChecksumSize)
+	payload = append(payload, a.Version)
+	payload = append(payload, a.PublicKey...)
+	return base58.Encode(append(payload, checksum(payload)...))
+}
+
+func ParseAddress(s string) (Address, error) {
+	bs, err := base58.Decode(s)
+	if err != nil {
+		return Address{}, fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+	if len(bs) != 1+ed25519.PublicKeySize+ChecksumSize {
+		return Address{}, fmt.Errorf("%w: %d bytes", ErrInvalidAddress, len(bs))
+	}
+	payload, sum := bs[:len(bs)-ChecksumSize], bs[len(bs)-ChecksumSize:]
+	if !bytes.Equal(checksum(payload), sum) {
+		return Address{}, fmt.Errorf("%w: %s", ErrInvalidChecksum, s)
+	}
+	if payload[0] != MainNetVersion && payload[0] != TestNetVersion {
+		return Address{}, fmt.Errorf("%w: %#x", ErrUnknownVersion, payload[0])
+	}
+	return Address{Version: payload[0], PublicKey: ed25519.PublicKey(payload[1:])}, nil
+}
+
+func ValidateAddress(s string) error {
+	_, err := ParseAddress(s)
+	return err
+}
+
+func ValidateNetworkAddress(s string, version byte) error {
+	a, err := ParseAddress(s)
+	if err != nil {
+		return err
+	}
+	if a.Version != version {
+		return fmt.Errorf("%w: version %#x, want %#x", ErrWrongNetwork, a.Version, version)
+	}
+	return nil
+}
+
+func IsOwnedBy(s string, pub string) (bool, error) {
+	a, err := ParseAddress(s)
+	if err != nil {
+		return false, err
+	}
+	bs, err := base58.Decode(pub)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(a.PublicKey, bs), nil
+}
@@ -3,40 +3,40 @@ package block
 import (
 	"crypto/sha256"
 	"encoding/hex"
-	"encoding/json"
 
 	"github.com/guiferpa/jackiechain/transaction"
 )
 
 type BlockHeader struct {
 	Version            string `json:"version"`
+	Height             int64  `json:"height"`
 	MerkleTreeRootHash string `json:"merkle_tree_root_hash"`
-	Nonce              int    `json:"nonce"`
+	UTxOSetRootHash    string `json:"utxo_set_root_hash"`
+	Bits               uint32 `json:"bits"`
+	Nonce              uint32 `json:"nonce"`
 	Timestamp          int64  `json:"timestamp"`
 	PreviousBlockHash  string `json:"previous_block_hash"`
 }
 
-func (bh BlockHeader) Bytes() ([]byte, error) {
-	bs, err := json.Marshal(&bh)
-	if err != nil {
-		return nil, err
-	}
-	return bs, nil
-}
-
 type Block struct {
 	Header       BlockHeader
-	Transactions transaction.TxMap
+	Transactions transaction.TxSlice
 }
 
+type BlockSlice []Block
+
 type BlockMap map[string]Block
 
-func GenerateBlockHash(b Block) (string, error) {
+func GenerateBlockHeaderHash(bh BlockHeader) (string, error) {
 	h := sha256.New()
-	bs, err := b.Header.Bytes()
+	bs, err := bh.Bytes()
 	if err != nil {
 		return "", err
 	}
 	h.Write(bs)
 	return hex.EncodeToString(h.Sum(nil)), nil
 }
+
+func GenerateBlockHash(b Block) (string, error) {
+	return GenerateBlockHeaderHash(b.Header)
+}
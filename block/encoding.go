@@ -0,0 +1,81 @@
+package block
+
+import (
+	"github.com/guiferpa/jackiechain/codec"
+	"github.com/guiferpa/jackiechain/transaction"
+)
+
+func writeBlockHeader(w *codec.Writer, bh BlockHeader) {
+	w.String(bh.Version)
+	w.Int64(bh.Height)
+	w.String(bh.MerkleTreeRootHash)
+	w.String(bh.UTxOSetRootHash)
+	w.Uint32(bh.Bits)
+	w.Uint32(bh.Nonce)
+	w.Int64(bh.Timestamp)
+	w.String(bh.PreviousBlockHash)
+}
+
+func readBlockHeader(r *codec.Reader) BlockHeader {
+	var bh BlockHeader
+	bh.Version = r.String()
+	bh.Height = r.Int64()
+	bh.MerkleTreeRootHash = r.String()
+	bh.UTxOSetRootHash = r.String()
+	bh.Bits = r.Uint32()
+	bh.Nonce = r.Uint32()
+	bh.Timestamp = r.Int64()
+	bh.PreviousBlockHash = r.String()
+	return bh
+}
+
+func (bh BlockHeader) Bytes() ([]byte, error) {
+	w := codec.NewWriter()
+	writeBlockHeader(w, bh)
+	return w.Bytes()
+}
+
+func DecodeBlockHeader(bs []byte) (BlockHeader, error) {
+	r := codec.NewReader(bs)
+	bh := readBlockHeader(r)
+	if err := r.Close(); err != nil {
+		return BlockHeader{}, err
+	}
+	return bh, nil
+}
+
+func (b Block) Bytes() ([]byte, error) {
+	w := codec.NewWriter()
+	writeBlockHeader(w, b.Header)
+	w.Len(len(b.Transactions))
+	for _, tx := range b.Transactions {
+		bs, err := tx.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		w.VarBytes(bs)
+	}
+	return w.Bytes()
+}
+
+func DecodeBlock(bs []byte) (Block, error) {
+	r := codec.NewReader(bs)
+	b := Block{Header: readBlockHeader(r)}
+	if n := r.Count(4 + transaction.MinTxSize); n > 0 {
+		b.Transactions = make(transaction.TxSlice, n)
+		for i := range b.Transactions {
+			tx, err := transaction.DecodeTx(r.VarBytes())
+			if err := r.Err(); err != nil {
+				return Block{}, err
+			}
+			if err != nil {
+				return Block{}, err
+			}
+			b.Transactions[i] = tx
+		}
+	}
+	if err := r.Close(); err != nil {
+		return Block{}, err
+	}
+	return b, nil
+}
@@ -0,0 +1,81 @@
+package block
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/guiferpa/jackiechain/codec"
+	"github.com/guiferpa/jackiechain/transaction"
+)
+
+func newTestBlock() Block {
+	return Block{
+		Header: BlockHeader{
+			Version:            "2",
+			Height:             7,
+			MerkleTreeRootHash: "merkle",
+			UTxOSetRootHash:    "utxos",
+			Bits:               0x1f00ffff,
+			Nonce:              42,
+			Timestamp:          1700000000000,
+			PreviousBlockHash:  "prev",
+		},
+		Transactions: transaction.TxSlice{
+			transaction.NewCoinbaseTx("miner", 50, 7),
+			{
+				TxIns:     transaction.TxInSlice{{PreviousOutPoint: transaction.OutPoint{TxHash: "a1", Index: 1}, PublicKey: "pub", Signature: []byte{1}}},
+				TxOuts:    transaction.TxOutSlice{{Receiver: "alice", Value: 10}},
+				Timestamp: 1700000000,
+			},
+		},
+	}
+}
+
+func TestBlockHeaderRoundTrip(t *testing.T) {
+	bh := newTestBlock().Header
+	bs, err := bh.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeBlockHeader(bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != bh {
+		t.Fatalf("got %+v, want %+v", got, bh)
+	}
+}
+
+func TestBlockRoundTrip(t *testing.T) {
+	cases := map[string]Block{
+		"no txs":   {Header: newTestBlock().Header},
+		"with txs": newTestBlock(),
+	}
+	for name, b := range cases {
+		t.Run(name, func(t *testing.T) {
+			bs, err := b.Bytes()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := DecodeBlock(bs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, b) {
+				t.Fatalf("got %+v, want %+v", got, b)
+			}
+		})
+	}
+}
+
+func TestDecodeBlockRejectsForgedTxCount(t *testing.T) {
+	bs, err := newTestBlock().Header.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged := append(bs, 0xff, 0xff, 0xff, 0xff)
+	if _, err := DecodeBlock(forged); !errors.Is(err, codec.ErrShortBuffer) {
+		t.Fatalf("got error %v, want %v", err, codec.ErrShortBuffer)
+	}
+}
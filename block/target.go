@@ -0,0 +1,58 @@
+package block
+
+import "math/big"
+
+var oneLsh256 = new(big.Int).Lsh(big.NewInt(1), 256)
+
+func CompactToBig(compact uint32) *big.Int {
+	mantissa := compact & 0x007fffff
+	negative := compact&0x00800000 != 0
+	exponent := uint(compact >> 24)
+	var n *big.Int
+	if exponent <= 3 {
+		mantissa >>= 8 * (3 - exponent)
+		n = big.NewInt(int64(mantissa))
+	} else {
+		n = big.NewInt(int64(mantissa))
+		n.Lsh(n, 8*(exponent-3))
+	}
+	if negative {
+		n.Neg(n)
+	}
+	return n
+}
+
+func BigToCompact(n *big.Int) uint32 {
+	if n.Sign() == 0 {
+		return 0
+	}
+	abs := new(big.Int).Abs(n)
+	exponent := uint(len(abs.Bytes()))
+	var mantissa uint32
+	if exponent <= 3 {
+		mantissa = uint32(abs.Uint64()) << (8 * (3 - exponent))
+	} else {
+		mantissa = uint32(abs.Rsh(abs, 8*(exponent-3)).Uint64())
+	}
+	if mantissa&0x00800000 != 0 {
+		mantissa >>= 8
+		exponent++
+	}
+	compact := uint32(exponent<<24) | mantissa
+	if n.Sign() < 0 {
+		compact |= 0x00800000
+	}
+	return compact
+}
+
+func CalcWork(bits uint32) *big.Int {
+	target := CompactToBig(bits)
+	if target.Sign() <= 0 {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Div(oneLsh256, target.Add(target, big.NewInt(1)))
+}
+
+func HashToBig(h string) (*big.Int, bool) {
+	return new(big.Int).SetString(h, 16)
+}
@@ -3,75 +3,270 @@ package blockchain
 import (
 	"errors"
 	"fmt"
-	"maps"
-	"strings"
 	"time"
 
+	"github.com/guiferpa/jackiechain/address"
 	"github.com/guiferpa/jackiechain/block"
 	"github.com/guiferpa/jackiechain/merkletree"
+	"github.com/guiferpa/jackiechain/store"
 	"github.com/guiferpa/jackiechain/transaction"
 )
 
 type Blockchain struct {
-	Blocks           block.BlockMap
-	Txs              transaction.TxMap
-	PendingTxs       transaction.TxMap
-	MiningDifficulty int
-	UTxOs            transaction.UTxOMap
-	GenesisBlock     *block.Block
-	LatestBlock      *block.Block
+	Store               store.Store
+	PendingTxs          transaction.TxMap
+	PendingUTxOs        transaction.UTxOMap
+	PendingSpends       map[string]string
+	NetworkVersion      byte
+	MiningBits          uint32
+	BlockVersion2Height int64
+	TargetBlockTime     time.Duration
+	RetargetInterval    int64
+	InitialSubsidy      int64
+	HalvingInterval     int64
+	MaxBlockTxs         int
+	MaxBlockSize        int
+	UTxOs               transaction.UTxOMap
+	GenesisBlock        *block.Block
+	LatestBlock         *block.Block
+	listeners           []func(Event)
+	invalidBlocks       map[string]struct{}
+	utxoTree            *merkletree.SparseTree
 }
 
-func MiningBlock(bc *Blockchain, b *block.Block) (string, error) {
-	challenge := strings.Repeat("0", bc.MiningDifficulty)
-	h, err := block.GenerateBlockHash(*b)
+type DoubleSpendError struct {
+	UTxOHash string
+	TxHash   string
+	SpentBy  string
+}
+
+func (e *DoubleSpendError) Error() string {
+	if e.SpentBy == "" {
+		return fmt.Sprintf("tx %s spends utxo %s already spent on chain", e.TxHash, e.UTxOHash)
+	}
+	return fmt.Sprintf("tx %s spends utxo %s already spent by tx %s", e.TxHash, e.UTxOHash, e.SpentBy)
+}
+
+func GetBlockSubsidy(bc *Blockchain, height int64) int64 {
+	if bc.HalvingInterval <= 0 {
+		return bc.InitialSubsidy
+	}
+	halvings := height / bc.HalvingInterval
+	if halvings >= 63 {
+		return 0
+	}
+	return bc.InitialSubsidy >> halvings
+}
+
+func GetTx(bc *Blockchain, h string) (*transaction.Tx, error) {
+	bh, err := bc.Store.GetTxBlockHash(h)
 	if err != nil {
-		fmt.Println(err)
-		return "", err
+		return nil, err
 	}
-	for challenge != h[0:bc.MiningDifficulty] {
-		b.Header.Nonce++
-		h, err = block.GenerateBlockHash(*b)
-		if err != nil {
-			return "", err
+	b, err := bc.Store.GetBlock(bh)
+	if err != nil {
+		return nil, err
+	}
+	txhs, err := b.Transactions.GenerateTxHashes()
+	if err != nil {
+		return nil, err
+	}
+	for i, txh := range txhs {
+		if txh == h {
+			return &b.Transactions[i], nil
 		}
 	}
-	return h, nil
+	return nil, store.ErrNotFound
 }
 
-func BuildBlock(bc *Blockchain) (string, error) {
-	txhs, err := bc.PendingTxs.ToSlice().GenerateTxHashes()
+func GetTxProof(bc *Blockchain, h string) (string, *merkletree.Proof, error) {
+	bh, err := bc.Store.GetTxBlockHash(h)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
-	b := &block.Block{
-		Header: block.BlockHeader{
-			Version:            "1",
-			MerkleTreeRootHash: merkletree.GenerateRootHash(txhs),
-			Timestamp:          time.Now().UnixMilli(),
-		},
-		Transactions: bc.PendingTxs,
+	b, err := bc.Store.GetBlock(bh)
+	if err != nil {
+		return "", nil, err
+	}
+	txhs, err := b.Transactions.GenerateTxHashes()
+	if err != nil {
+		return "", nil, err
+	}
+	tree, err := generateMerkleTree(b.Header.Version, b.Transactions)
+	if err != nil {
+		return "", nil, err
+	}
+	for i, txh := range txhs {
+		if txh == h {
+			p, err := tree.Proof(i)
+			return bh, p, err
+		}
+	}
+	return "", nil, store.ErrNotFound
+}
+
+func isSpentOnChain(bc *Blockchain, op transaction.OutPoint) (bool, error) {
+	tx, err := GetTx(bc, op.TxHash)
+	if errors.Is(err, store.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return op.Index >= 0 && op.Index < len(tx.TxOuts), nil
+}
+
+func (bc *Blockchain) Height() int64 {
+	if bc.LatestBlock == nil {
+		return -1
 	}
-	h, err := MiningBlock(bc, b)
+	return bc.LatestBlock.Header.Height
+}
+
+func (bc *Blockchain) GetBlockByHash(h string) (*block.Block, error) {
+	return bc.Store.GetBlock(h)
+}
+
+func (bc *Blockchain) GetBlockByHeight(height int64) (*block.Block, error) {
+	h, err := bc.Store.GetBlockHashByHeight(height)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	if bc.GenesisBlock == nil {
-		b.Header.PreviousBlockHash = strings.Repeat("0", 64)
-		bc.GenesisBlock = b
-		bc.LatestBlock = b
-	} else {
-		pv, err := block.GenerateBlockHash(*bc.LatestBlock)
+	return bc.Store.GetBlock(h)
+}
+
+func (bc *Blockchain) GetHeaders(from int64, count int) ([]block.BlockHeader, error) {
+	bhs := make([]block.BlockHeader, 0, count)
+	for height := from; height <= bc.Height() && len(bhs) < count; height++ {
+		b, err := bc.GetBlockByHeight(height)
 		if err != nil {
-			return "", err
+			return nil, err
+		}
+		bhs = append(bhs, b.Header)
+	}
+	return bhs, nil
+}
+
+func getTip(bc *Blockchain) (*store.Tip, error) {
+	tip, err := bc.Store.GetTip()
+	if errors.Is(err, store.ErrNotFound) {
+		return &store.Tip{Hash: GenesisPreviousBlockHash, Height: -1}, nil
+	}
+	return tip, err
+}
+
+func Restore(bc *Blockchain) error {
+	tip, err := getTip(bc)
+	if err != nil {
+		return err
+	}
+	if tip.Height < 0 {
+		return nil
+	}
+	latest, err := bc.Store.GetBlock(tip.Hash)
+	if err != nil {
+		return err
+	}
+	gh, err := bc.Store.GetBlockHashByHeight(0)
+	if err != nil {
+		return err
+	}
+	genesis, err := bc.Store.GetBlock(gh)
+	if err != nil {
+		return err
+	}
+	utxos, err := bc.Store.GetUTxOs()
+	if err != nil {
+		return err
+	}
+	tree, err := generateUTxOTree(utxos)
+	if err != nil {
+		return err
+	}
+	bc.GenesisBlock = genesis
+	bc.LatestBlock = latest
+	bc.UTxOs = utxos
+	bc.utxoTree = tree
+	return nil
+}
+
+type BlockTemplate struct {
+	Block *block.Block
+	// MinTimestamp is the earliest timestamp the block may carry, one
+	// millisecond past the median time of the blocks before it.
+	MinTimestamp int64
+	utxos        *merkletree.SparseTree
+}
+
+func NewBlockTemplate(bc *Blockchain, miner string) (*BlockTemplate, error) {
+	tip, err := getTip(bc)
+	if err != nil {
+		return nil, err
+	}
+	height := tip.Height + 1
+	txs, fees, err := SelectPendingTxs(bc, transaction.NewCoinbaseTx(miner, GetBlockSubsidy(bc, height), height))
+	if err != nil {
+		return nil, err
+	}
+	utxos, err := getUTxOTree(bc)
+	if err != nil {
+		return nil, err
+	}
+	utxos = utxos.Clone()
+	for _, tx := range txs {
+		if err := applyTxToUTxOTree(utxos, tx); err != nil {
+			return nil, err
 		}
-		b.Header.PreviousBlockHash = pv
-		bc.LatestBlock = b
 	}
-	bc.Blocks[h] = *b
-	maps.Copy(bc.Txs, bc.PendingTxs)
-	bc.PendingTxs = make(transaction.TxMap)
-	return h, nil
+	bits, err := GetNextBits(bc, tip.Hash)
+	if err != nil {
+		return nil, err
+	}
+	mtp, err := medianTimePast(bc, tip.Hash)
+	if err != nil {
+		return nil, err
+	}
+	t := &BlockTemplate{
+		Block: &block.Block{
+			Header: block.BlockHeader{
+				Version:           GetBlockVersion(bc, height),
+				Height:            height,
+				Bits:              bits,
+				Timestamp:         max(time.Now().UnixMilli(), mtp+1),
+				PreviousBlockHash: tip.Hash,
+			},
+			Transactions: append(transaction.TxSlice{transaction.NewCoinbaseTx(miner, GetBlockSubsidy(bc, height)+fees, height)}, txs...),
+		},
+		MinTimestamp: mtp + 1,
+		utxos:        utxos,
+	}
+	if err := commitBlockTemplate(t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func commitBlockTemplate(t *BlockTemplate) error {
+	b := t.Block
+	root, err := generateMerkleRootHash(b.Header.Version, b.Transactions)
+	if err != nil {
+		return err
+	}
+	utxos := t.utxos.Clone()
+	if err := applyTxToUTxOTree(utxos, b.Transactions[0]); err != nil {
+		return err
+	}
+	b.Header.MerkleTreeRootHash = root
+	b.Header.UTxOSetRootHash = utxos.Root()
+	return nil
+}
+
+func IncrementExtraNonce(t *BlockTemplate) error {
+	if len(t.Block.Transactions) == 0 || !t.Block.Transactions[0].IsCoinbase() {
+		return errors.New("block doesn't start with a coinbase tx")
+	}
+	t.Block.Transactions[0].ExtraNonce++
+	return commitBlockTemplate(t)
 }
 
 func AddTx(bc *Blockchain, tx transaction.Tx) error {
@@ -79,21 +274,85 @@ func AddTx(bc *Blockchain, tx transaction.Tx) error {
 	if err != nil {
 		return err
 	}
-	has, err := transaction.TxHasValidSignature(tx)
+	if _, ok := bc.PendingTxs[h]; ok {
+		return fmt.Errorf("tx %s is already pending", h)
+	}
+	if _, err := bc.Store.GetTxBlockHash(h); err == nil {
+		return fmt.Errorf("tx %s is already on chain", h)
+	} else if !errors.Is(err, store.ErrNotFound) {
+		return err
+	}
+	if len(tx.TxIns) == 0 {
+		return errors.New("tx has no inputs")
+	}
+	spent := make(map[string]struct{}, len(tx.TxIns))
+	var inputs int64
+	for i, txin := range tx.TxIns {
+		utxoh, err := transaction.GenerateOutPointHash(txin.PreviousOutPoint)
+		if err != nil {
+			return err
+		}
+		if _, ok := spent[utxoh]; ok {
+			return &DoubleSpendError{UTxOHash: utxoh, TxHash: h, SpentBy: h}
+		}
+		if by, ok := bc.PendingSpends[utxoh]; ok {
+			return &DoubleSpendError{UTxOHash: utxoh, TxHash: h, SpentBy: by}
+		}
+		utxo, ok := bc.UTxOs[utxoh]
+		if !ok {
+			utxo, ok = bc.PendingUTxOs[utxoh]
+		}
+		if !ok {
+			spentonchain, err := isSpentOnChain(bc, txin.PreviousOutPoint)
+			if err != nil {
+				return err
+			}
+			if spentonchain {
+				return &DoubleSpendError{UTxOHash: utxoh, TxHash: h}
+			}
+			return fmt.Errorf("tx input %d references unknown utxo %s", i, utxoh)
+		}
+		owned, err := address.IsOwnedBy(utxo.Receiver, txin.PublicKey)
+		if err != nil {
+			return err
+		}
+		if !owned {
+			return fmt.Errorf("tx input %d public key doesn't own utxo %s", i, utxoh)
+		}
+		has, err := transaction.TxInHasValidSignature(tx, i)
+		if err != nil {
+			return err
+		}
+		if !has {
+			return fmt.Errorf("no valid signature for tx input %d", i)
+		}
+		spent[utxoh] = struct{}{}
+		if inputs, err = transaction.AddMoney(inputs, utxo.Value); err != nil {
+			return err
+		}
+	}
+	if err := validateTxOutputs(bc, tx); err != nil {
+		return err
+	}
+	outputs, err := tx.OutputsValue()
 	if err != nil {
 		return err
 	}
-	if !has {
-		return errors.New("no valid tx signature")
+	if inputs < outputs {
+		return errors.New("tx outputs exceed its inputs")
 	}
 	bc.PendingTxs[h] = tx
-	for _, txout := range tx.TxOuts {
-		utxo := transaction.GenerateUTxOFromTxOut(txout)
+	for utxoh := range spent {
+		bc.PendingSpends[utxoh] = h
+	}
+	for i, txout := range tx.TxOuts {
+		utxo := transaction.GenerateUTxOFromTxOut(h, i, txout)
 		utxoh, err := transaction.GenerateUTxOHash(utxo)
 		if err != nil {
 			return err
 		}
-		bc.UTxOs[utxoh] = utxo
+		bc.PendingUTxOs[utxoh] = utxo
 	}
+	emit(bc, Event{Type: TxAccepted, Hash: h, Tx: tx})
 	return nil
 }
@@ -0,0 +1,429 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/guiferpa/jackiechain/block"
+	"github.com/guiferpa/jackiechain/store"
+	"github.com/guiferpa/jackiechain/transaction"
+)
+
+func GetChainWork(bc *Blockchain, h string) (*big.Int, error) {
+	if h == GenesisPreviousBlockHash {
+		return big.NewInt(0), nil
+	}
+	return bc.Store.GetChainWork(h)
+}
+
+func getBlockChainWork(bc *Blockchain, bh block.BlockHeader) (*big.Int, error) {
+	work, err := GetChainWork(bc, bh.PreviousBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	return work.Add(work, block.CalcWork(bh.Bits)), nil
+}
+
+func putBlock(s store.Batch, h string, b block.Block, work *big.Int) error {
+	if err := s.PutBlock(h, b); err != nil {
+		return err
+	}
+	return s.PutChainWork(h, work)
+}
+
+func markInvalid(bc *Blockchain, hs ...string) {
+	if bc.invalidBlocks == nil {
+		bc.invalidBlocks = make(map[string]struct{})
+	}
+	for _, h := range hs {
+		bc.invalidBlocks[h] = struct{}{}
+	}
+}
+
+func isInvalid(bc *Blockchain, h string) bool {
+	_, ok := bc.invalidBlocks[h]
+	return ok
+}
+
+func connectBlock(bc *Blockchain, h string, b block.Block) error {
+	if err := ValidateBlock(bc, b); err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrInvalidBlock, h, err)
+	}
+	work, err := getBlockChainWork(bc, b.Header)
+	if err != nil {
+		return err
+	}
+	txhs, err := b.Transactions.GenerateTxHashes()
+	if err != nil {
+		return err
+	}
+	created := make(transaction.UTxOMap)
+	for j, tx := range b.Transactions {
+		for i, txout := range tx.TxOuts {
+			utxo := transaction.GenerateUTxOFromTxOut(txhs[j], i, txout)
+			utxoh, err := transaction.GenerateUTxOHash(utxo)
+			if err != nil {
+				return err
+			}
+			created[utxoh] = utxo
+		}
+	}
+	spent := make(transaction.UTxOSlice, 0)
+	spenths := make([]string, 0)
+	for _, tx := range b.Transactions {
+		for _, txin := range tx.TxIns {
+			utxoh, err := transaction.GenerateOutPointHash(txin.PreviousOutPoint)
+			if err != nil {
+				return err
+			}
+			if utxo, ok := bc.UTxOs[utxoh]; ok {
+				spent = append(spent, utxo)
+			}
+			spenths = append(spenths, utxoh)
+		}
+	}
+	tree, err := getUTxOTree(bc)
+	if err != nil {
+		return err
+	}
+	tree = tree.Clone()
+	for _, utxo := range created {
+		if err := putUTxOLeaf(tree, utxo); err != nil {
+			return err
+		}
+	}
+	for _, utxoh := range spenths {
+		if err := tree.Delete(utxoh); err != nil {
+			return err
+		}
+	}
+	err = bc.Store.Update(func(s store.Batch) error {
+		if err := putBlock(s, h, b, work); err != nil {
+			return err
+		}
+		if err := s.PutUndo(h, spent); err != nil {
+			return err
+		}
+		for utxoh, utxo := range created {
+			if err := s.PutUTxO(utxoh, utxo); err != nil {
+				return err
+			}
+		}
+		for _, txh := range txhs {
+			if err := s.PutTxBlockHash(txh, h); err != nil {
+				return err
+			}
+		}
+		for _, utxoh := range spenths {
+			if err := s.DeleteUTxO(utxoh); err != nil {
+				return err
+			}
+		}
+		if err := s.PutBlockHashByHeight(b.Header.Height, h); err != nil {
+			return err
+		}
+		return s.PutTip(store.Tip{Hash: h, Height: b.Header.Height})
+	})
+	if err != nil {
+		return err
+	}
+	for utxoh, utxo := range created {
+		bc.UTxOs[utxoh] = utxo
+	}
+	for _, utxoh := range spenths {
+		delete(bc.UTxOs, utxoh)
+	}
+	bc.utxoTree = tree
+	if b.Header.Height == 0 {
+		bc.GenesisBlock = &b
+	}
+	bc.LatestBlock = &b
+	emit(bc, Event{Type: BlockConnected, Hash: h, Block: b})
+	for _, txh := range txhs {
+		if err := removePendingTx(bc, txh); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func disconnectBlock(bc *Blockchain, h string, b block.Block) error {
+	txhs, err := b.Transactions.GenerateTxHashes()
+	if err != nil {
+		return err
+	}
+	createdhs := make([]string, 0)
+	for j, tx := range b.Transactions {
+		for i, txout := range tx.TxOuts {
+			utxoh, err := transaction.GenerateUTxOHash(transaction.GenerateUTxOFromTxOut(txhs[j], i, txout))
+			if err != nil {
+				return err
+			}
+			createdhs = append(createdhs, utxoh)
+		}
+	}
+	spent, err := bc.Store.GetUndo(h)
+	if err != nil {
+		return err
+	}
+	restored := make(transaction.UTxOMap, len(spent))
+	for _, utxo := range spent {
+		utxoh, err := transaction.GenerateUTxOHash(utxo)
+		if err != nil {
+			return err
+		}
+		restored[utxoh] = utxo
+	}
+	tree, err := getUTxOTree(bc)
+	if err != nil {
+		return err
+	}
+	tree = tree.Clone()
+	for _, utxoh := range createdhs {
+		if err := tree.Delete(utxoh); err != nil {
+			return err
+		}
+	}
+	for _, utxo := range restored {
+		if err := putUTxOLeaf(tree, utxo); err != nil {
+			return err
+		}
+	}
+	tip := store.Tip{Hash: b.Header.PreviousBlockHash, Height: b.Header.Height - 1}
+	var parent *block.Block
+	if tip.Height >= 0 {
+		if parent, err = bc.Store.GetBlock(tip.Hash); err != nil {
+			return err
+		}
+	}
+	err = bc.Store.Update(func(s store.Batch) error {
+		for _, utxoh := range createdhs {
+			if err := s.DeleteUTxO(utxoh); err != nil {
+				return err
+			}
+		}
+		for _, txh := range txhs {
+			if err := s.DeleteTxBlockHash(txh); err != nil {
+				return err
+			}
+		}
+		for utxoh, utxo := range restored {
+			if err := s.PutUTxO(utxoh, utxo); err != nil {
+				return err
+			}
+		}
+		if err := s.DeleteBlockHashByHeight(b.Header.Height); err != nil {
+			return err
+		}
+		return s.PutTip(tip)
+	})
+	if err != nil {
+		return err
+	}
+	for _, utxoh := range createdhs {
+		delete(bc.UTxOs, utxoh)
+	}
+	for utxoh, utxo := range restored {
+		bc.UTxOs[utxoh] = utxo
+	}
+	bc.utxoTree = tree
+	if parent == nil {
+		bc.GenesisBlock = nil
+	}
+	bc.LatestBlock = parent
+	emit(bc, Event{Type: BlockDisconnected, Hash: h, Block: b})
+	return nil
+}
+
+func isOnMainChain(bc *Blockchain, h string, height int64) (bool, error) {
+	mh, err := bc.Store.GetBlockHashByHeight(height)
+	if errors.Is(err, store.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return mh == h, nil
+}
+
+func disconnectTo(bc *Blockchain, fork string) (block.BlockSlice, error) {
+	disconnected := make(block.BlockSlice, 0)
+	for {
+		tip, err := getTip(bc)
+		if err != nil {
+			return nil, err
+		}
+		if tip.Hash == fork {
+			return disconnected, nil
+		}
+		b, err := bc.Store.GetBlock(tip.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if err := disconnectBlock(bc, tip.Hash, *b); err != nil {
+			return nil, err
+		}
+		disconnected = append(disconnected, *b)
+	}
+}
+
+// connectBranch connects bs from its last block to its first and returns the
+// index of the block that failed to connect.
+func connectBranch(bc *Blockchain, bs block.BlockSlice) (int, error) {
+	for i := len(bs) - 1; i >= 0; i-- {
+		h, err := block.GenerateBlockHash(bs[i])
+		if err != nil {
+			return i, err
+		}
+		if err := connectBlock(bc, h, bs[i]); err != nil {
+			return i, err
+		}
+	}
+	return -1, nil
+}
+
+func markInvalidBranch(bc *Blockchain, bs block.BlockSlice) error {
+	for _, b := range bs {
+		h, err := block.GenerateBlockHash(b)
+		if err != nil {
+			return err
+		}
+		markInvalid(bc, h)
+	}
+	return nil
+}
+
+func restorePendingTxs(bc *Blockchain, bs block.BlockSlice) {
+	txs := make(transaction.TxSlice, 0)
+	for _, b := range bs {
+		for _, tx := range b.Transactions {
+			if !tx.IsCoinbase() {
+				txs = append(txs, tx)
+			}
+		}
+	}
+	for added := true; added; {
+		added = false
+		rest := make(transaction.TxSlice, 0, len(txs))
+		for _, tx := range txs {
+			if err := AddTx(bc, tx); err != nil {
+				rest = append(rest, tx)
+				continue
+			}
+			added = true
+		}
+		txs = rest
+	}
+}
+
+func reorganize(bc *Blockchain, h string) error {
+	branch := make(block.BlockSlice, 0)
+	fork := h
+	for fork != GenesisPreviousBlockHash {
+		if isInvalid(bc, fork) {
+			markInvalid(bc, h)
+			if err := markInvalidBranch(bc, branch); err != nil {
+				return err
+			}
+			return fmt.Errorf("%w: %s descends from invalid block %s", ErrInvalidBlock, h, fork)
+		}
+		b, err := bc.Store.GetBlock(fork)
+		if err != nil {
+			return err
+		}
+		onmain, err := isOnMainChain(bc, fork, b.Header.Height)
+		if err != nil {
+			return err
+		}
+		if onmain {
+			break
+		}
+		branch = append(branch, *b)
+		fork = b.Header.PreviousBlockHash
+	}
+	disconnected, err := disconnectTo(bc, fork)
+	if err != nil {
+		return err
+	}
+	if i, err := connectBranch(bc, branch); err != nil {
+		if errors.Is(err, ErrInvalidBlock) {
+			if merr := markInvalidBranch(bc, branch[:i+1]); merr != nil {
+				return errors.Join(err, merr)
+			}
+		}
+		partial, rerr := disconnectTo(bc, fork)
+		if rerr != nil {
+			return errors.Join(err, rerr)
+		}
+		if _, rerr := connectBranch(bc, disconnected); rerr != nil {
+			return errors.Join(err, rerr)
+		}
+		restorePendingTxs(bc, partial)
+		if rerr := evictConflictingPendingTxs(bc); rerr != nil {
+			return errors.Join(err, rerr)
+		}
+		return fmt.Errorf("reorganization to block %s failed: %w", h, err)
+	}
+	restorePendingTxs(bc, disconnected)
+	return nil
+}
+
+func AddBlock(bc *Blockchain, b block.Block) (string, error) {
+	h, err := block.GenerateBlockHash(b)
+	if err != nil {
+		return "", err
+	}
+	if isInvalid(bc, h) {
+		return "", fmt.Errorf("%w: %s", ErrInvalidBlock, h)
+	}
+	if isInvalid(bc, b.Header.PreviousBlockHash) {
+		markInvalid(bc, h)
+		return "", fmt.Errorf("%w: %s descends from invalid block %s", ErrInvalidBlock, h, b.Header.PreviousBlockHash)
+	}
+	if _, err := bc.Store.GetBlock(h); err == nil {
+		return "", fmt.Errorf("%w: %s", ErrBlockAlreadyKnown, h)
+	} else if !errors.Is(err, store.ErrNotFound) {
+		return "", err
+	}
+	if err := ValidateHeader(bc, b.Header); err != nil {
+		return "", err
+	}
+	if err := ValidateMerkleRoot(b); err != nil {
+		return "", err
+	}
+	tip, err := getTip(bc)
+	if err != nil {
+		return "", err
+	}
+	if b.Header.PreviousBlockHash == tip.Hash {
+		if err := connectBlock(bc, h, b); err != nil {
+			if errors.Is(err, ErrInvalidBlock) {
+				markInvalid(bc, h)
+			}
+			return "", err
+		}
+		return h, evictConflictingPendingTxs(bc)
+	}
+	work, err := getBlockChainWork(bc, b.Header)
+	if err != nil {
+		return "", err
+	}
+	err = bc.Store.Update(func(s store.Batch) error {
+		return putBlock(s, h, b, work)
+	})
+	if err != nil {
+		return "", err
+	}
+	tipwork, err := GetChainWork(bc, tip.Hash)
+	if err != nil {
+		return "", err
+	}
+	if work.Cmp(tipwork) <= 0 {
+		return h, nil
+	}
+	if err := reorganize(bc, h); err != nil {
+		return "", err
+	}
+	return h, evictConflictingPendingTxs(bc)
+}
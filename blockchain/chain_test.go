@@ -0,0 +1,253 @@
+package blockchain
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/guiferpa/jackiechain/address"
+	"github.com/guiferpa/jackiechain/block"
+	"github.com/guiferpa/jackiechain/store"
+	"github.com/guiferpa/jackiechain/transaction"
+	"github.com/mr-tron/base58"
+)
+
+type testKey struct {
+	priv    ed25519.PrivateKey
+	pub     string
+	address string
+}
+
+func newTestKey(t *testing.T) testKey {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testKey{
+		priv:    priv,
+		pub:     base58.Encode(pub),
+		address: address.NewAddress(address.MainNetVersion, pub).String(),
+	}
+}
+
+func newTestChain() *Blockchain {
+	return &Blockchain{
+		Store:           store.NewMemory(),
+		PendingTxs:      make(transaction.TxMap),
+		PendingUTxOs:    make(transaction.UTxOMap),
+		PendingSpends:   make(map[string]string),
+		NetworkVersion:  address.MainNetVersion,
+		MiningBits:      0x207fffff,
+		TargetBlockTime: 10 * time.Second,
+		InitialSubsidy:  50,
+		UTxOs:           make(transaction.UTxOMap),
+	}
+}
+
+func solveBlock(t *testing.T, b *block.Block) string {
+	t.Helper()
+	target := block.CompactToBig(b.Header.Bits)
+	for {
+		h, err := block.GenerateBlockHeaderHash(b.Header)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n, ok := block.HashToBig(h); ok && n.Cmp(target) <= 0 {
+			return h
+		}
+		b.Header.Nonce++
+	}
+}
+
+// newTestBlock mines a template on bc's tip, letting tamper break it before
+// the merkle and utxo roots are committed.
+func newTestBlock(t *testing.T, bc *Blockchain, miner string, tamper func(*block.Block)) (string, block.Block) {
+	t.Helper()
+	tpl, err := NewBlockTemplate(bc, miner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tamper != nil {
+		tamper(tpl.Block)
+		if err := commitBlockTemplate(tpl); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return solveBlock(t, tpl.Block), *tpl.Block
+}
+
+func mineTestBlock(t *testing.T, bc *Blockchain, miner string) (string, block.Block) {
+	t.Helper()
+	h, b := newTestBlock(t, bc, miner, nil)
+	if _, err := AddBlock(bc, b); err != nil {
+		t.Fatal(err)
+	}
+	return h, b
+}
+
+func newTestSpend(t *testing.T, from testKey, utxo transaction.UTxO, to string) transaction.Tx {
+	t.Helper()
+	tx := transaction.Tx{
+		TxIns:     transaction.TxInSlice{{PreviousOutPoint: utxo.OutPoint, PublicKey: from.pub}},
+		TxOuts:    transaction.TxOutSlice{{Receiver: to, Value: utxo.Value - 1}},
+		Timestamp: time.Now().UnixNano(),
+	}
+	sig, err := transaction.SignTxIn(tx, 0, from.priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.TxIns[0].Signature = sig
+	return tx
+}
+
+func coinbaseUTxO(t *testing.T, b block.Block) transaction.UTxO {
+	t.Helper()
+	h, err := transaction.GenerateTxHash(b.Transactions[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return transaction.GenerateUTxOFromTxOut(h, 0, b.Transactions[0].TxOuts[0])
+}
+
+// newForkedChains returns two chains sharing a genesis block whose coinbase
+// pays miner.
+func newForkedChains(t *testing.T, miner testKey) (*Blockchain, *Blockchain, block.Block) {
+	t.Helper()
+	bc, fork := newTestChain(), newTestChain()
+	_, genesis := mineTestBlock(t, bc, miner.address)
+	if _, err := AddBlock(fork, genesis); err != nil {
+		t.Fatal(err)
+	}
+	return bc, fork, genesis
+}
+
+func assertTip(t *testing.T, bc *Blockchain, want string) {
+	t.Helper()
+	tip, err := getTip(bc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tip.Hash != want {
+		t.Fatalf("got tip %s, want %s", tip.Hash, want)
+	}
+	tree, err := generateUTxOTree(bc.UTxOs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := bc.utxoTree.Root(), tree.Root(); got != want {
+		t.Fatalf("got utxo root %s, want %s", got, want)
+	}
+	if got, want := bc.LatestBlock.Header.UTxOSetRootHash, tree.Root(); got != want {
+		t.Fatalf("tip commits to utxo root %s, want %s", got, want)
+	}
+}
+
+func TestReorganizeToHeavierBranch(t *testing.T) {
+	miner, other := newTestKey(t), newTestKey(t)
+	bc, fork, genesis := newForkedChains(t, miner)
+
+	spend := newTestSpend(t, miner, coinbaseUTxO(t, genesis), other.address)
+	spendh, err := transaction.GenerateTxHash(spend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := AddTx(bc, spend); err != nil {
+		t.Fatal(err)
+	}
+	mineTestBlock(t, bc, miner.address)
+	if _, ok := bc.PendingTxs[spendh]; ok {
+		t.Fatal("mined tx is still pending")
+	}
+
+	_, f1 := mineTestBlock(t, fork, other.address)
+	f2h, f2 := mineTestBlock(t, fork, other.address)
+	disconnected := 0
+	bc.Subscribe(func(ev Event) {
+		if ev.Type == BlockDisconnected {
+			disconnected++
+		}
+	})
+	if _, err := AddBlock(bc, f1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := AddBlock(bc, f2); err != nil {
+		t.Fatal(err)
+	}
+
+	assertTip(t, bc, f2h)
+	if disconnected != 1 {
+		t.Fatalf("got %d disconnected blocks, want 1", disconnected)
+	}
+	if _, ok := bc.PendingTxs[spendh]; !ok {
+		t.Fatal("tx from the disconnected block wasn't returned to the mempool")
+	}
+}
+
+func TestReorganizeRollsBackInvalidBranch(t *testing.T) {
+	miner, other := newTestKey(t), newTestKey(t)
+	bc, fork, genesis := newForkedChains(t, miner)
+	mineTestBlock(t, bc, miner.address)
+	m2h, _ := mineTestBlock(t, bc, miner.address)
+	utxos := len(bc.UTxOs)
+
+	spend := newTestSpend(t, miner, coinbaseUTxO(t, genesis), other.address)
+	spendh, err := transaction.GenerateTxHash(spend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := AddTx(fork, spend); err != nil {
+		t.Fatal(err)
+	}
+	_, f1 := mineTestBlock(t, fork, other.address)
+	f2h, f2 := newTestBlock(t, fork, other.address, func(b *block.Block) {
+		b.Transactions[0].TxOuts[0].Value++
+	})
+	f3 := block.Block{
+		Header: block.BlockHeader{
+			Version:           GetBlockVersion(fork, f2.Header.Height+1),
+			Height:            f2.Header.Height + 1,
+			Bits:              f2.Header.Bits,
+			Timestamp:         f2.Header.Timestamp + 1,
+			PreviousBlockHash: f2h,
+		},
+		Transactions: transaction.TxSlice{transaction.NewCoinbaseTx(other.address, 50, f2.Header.Height+1)},
+	}
+	if f3.Header.MerkleTreeRootHash, err = generateMerkleRootHash(f3.Header.Version, f3.Transactions); err != nil {
+		t.Fatal(err)
+	}
+	f3h := solveBlock(t, &f3)
+
+	for _, b := range []block.Block{f1, f2} {
+		if _, err := AddBlock(bc, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := AddBlock(bc, f3); !errors.Is(err, ErrInvalidBlock) {
+		t.Fatalf("got error %v, want %v", err, ErrInvalidBlock)
+	}
+
+	assertTip(t, bc, m2h)
+	if len(bc.UTxOs) != utxos {
+		t.Fatalf("got %d utxos after rollback, want %d", len(bc.UTxOs), utxos)
+	}
+	if _, ok := bc.PendingTxs[spendh]; !ok {
+		t.Fatal("tx from the rolled back branch wasn't returned to the mempool")
+	}
+	for _, h := range []string{f2h, f3h} {
+		if !isInvalid(bc, h) {
+			t.Fatalf("block %s wasn't marked invalid", h)
+		}
+	}
+
+	f4 := f3
+	f4.Header.Height++
+	f4.Header.Timestamp++
+	f4.Header.PreviousBlockHash = f3h
+	solveBlock(t, &f4)
+	if _, err := AddBlock(bc, f4); !errors.Is(err, ErrInvalidBlock) {
+		t.Fatalf("got error %v for a descendant of an invalid block, want %v", err, ErrInvalidBlock)
+	}
+}
@@ -0,0 +1,63 @@
+package blockchain
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/guiferpa/jackiechain/block"
+)
+
+const RetargetMaxFactor = 4
+
+func getAncestor(bc *Blockchain, h string, height int64) (*block.Block, error) {
+	b, err := bc.Store.GetBlock(h)
+	if err != nil {
+		return nil, err
+	}
+	for b.Header.Height > height {
+		b, err = bc.Store.GetBlock(b.Header.PreviousBlockHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if b.Header.Height != height {
+		return nil, fmt.Errorf("no ancestor at height %d for block %s", height, h)
+	}
+	return b, nil
+}
+
+func GetNextBits(bc *Blockchain, prev string) (uint32, error) {
+	if prev == GenesisPreviousBlockHash {
+		return bc.MiningBits, nil
+	}
+	parent, err := bc.Store.GetBlock(prev)
+	if err != nil {
+		return 0, err
+	}
+	height := parent.Header.Height + 1
+	if bc.RetargetInterval <= 0 || height%bc.RetargetInterval != 0 {
+		return parent.Header.Bits, nil
+	}
+	first, err := getAncestor(bc, prev, height-bc.RetargetInterval)
+	if err != nil {
+		return 0, err
+	}
+	actual := parent.Header.Timestamp - first.Header.Timestamp
+	expected := bc.TargetBlockTime.Milliseconds() * bc.RetargetInterval
+	if expected <= 0 {
+		return parent.Header.Bits, nil
+	}
+	if actual < expected/RetargetMaxFactor {
+		actual = expected / RetargetMaxFactor
+	}
+	if actual > expected*RetargetMaxFactor {
+		actual = expected * RetargetMaxFactor
+	}
+	target := block.CompactToBig(parent.Header.Bits)
+	target.Mul(target, big.NewInt(actual))
+	target.Div(target, big.NewInt(expected))
+	if limit := block.CompactToBig(bc.MiningBits); target.Cmp(limit) > 0 {
+		target = limit
+	}
+	return block.BigToCompact(target), nil
+}
@@ -0,0 +1,32 @@
+package blockchain
+
+import (
+	"github.com/guiferpa/jackiechain/block"
+	"github.com/guiferpa/jackiechain/transaction"
+)
+
+type EventType int
+
+const (
+	BlockConnected EventType = iota
+	BlockDisconnected
+	TxAccepted
+	TxRemoved
+)
+
+type Event struct {
+	Type  EventType
+	Hash  string
+	Block block.Block
+	Tx    transaction.Tx
+}
+
+func (bc *Blockchain) Subscribe(fn func(Event)) {
+	bc.listeners = append(bc.listeners, fn)
+}
+
+func emit(bc *Blockchain, ev Event) {
+	for _, fn := range bc.listeners {
+		fn(ev)
+	}
+}
@@ -0,0 +1,37 @@
+package blockchain
+
+import "github.com/guiferpa/jackiechain/block"
+
+type BlockIterator struct {
+	bc     *Blockchain
+	height int64
+	tip    int64
+	block  *block.Block
+	err    error
+}
+
+func (it *BlockIterator) Next() bool {
+	if it.err != nil || it.height >= it.tip {
+		return false
+	}
+	b, err := it.bc.GetBlockByHeight(it.height + 1)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	it.height++
+	it.block = b
+	return true
+}
+
+func (it *BlockIterator) Block() *block.Block {
+	return it.block
+}
+
+func (it *BlockIterator) Err() error {
+	return it.err
+}
+
+func (bc *Blockchain) Iterator() *BlockIterator {
+	return &BlockIterator{bc: bc, height: -1, tip: bc.Height()}
+}
@@ -0,0 +1,163 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/guiferpa/jackiechain/transaction"
+)
+
+type pendingTx struct {
+	Hash string
+	Tx   transaction.Tx
+	Fee  int64
+	Size int
+}
+
+func GetTxFee(bc *Blockchain, tx transaction.Tx) (int64, error) {
+	if tx.IsCoinbase() {
+		return 0, nil
+	}
+	var inputs int64
+	for _, txin := range tx.TxIns {
+		utxoh, err := transaction.GenerateOutPointHash(txin.PreviousOutPoint)
+		if err != nil {
+			return 0, err
+		}
+		utxo, ok := bc.UTxOs[utxoh]
+		if !ok {
+			utxo, ok = bc.PendingUTxOs[utxoh]
+		}
+		if !ok {
+			return 0, fmt.Errorf("tx input references unknown utxo %s", utxoh)
+		}
+		if inputs, err = transaction.AddMoney(inputs, utxo.Value); err != nil {
+			return 0, err
+		}
+	}
+	outputs, err := tx.OutputsValue()
+	if err != nil {
+		return 0, err
+	}
+	if inputs < outputs {
+		return 0, errors.New("tx outputs exceed its inputs")
+	}
+	return inputs - outputs, nil
+}
+
+func SelectPendingTxs(bc *Blockchain, coinbase transaction.Tx) (transaction.TxSlice, int64, error) {
+	bs, err := coinbase.Bytes()
+	if err != nil {
+		return nil, 0, err
+	}
+	size, count := len(bs), 1
+	ptxs := make([]pendingTx, 0, len(bc.PendingTxs))
+	for h, tx := range bc.PendingTxs {
+		fee, err := GetTxFee(bc, tx)
+		if err != nil {
+			continue
+		}
+		bs, err := tx.Bytes()
+		if err != nil {
+			return nil, 0, err
+		}
+		ptxs = append(ptxs, pendingTx{Hash: h, Tx: tx, Fee: fee, Size: len(bs)})
+	}
+	sort.Slice(ptxs, func(i, j int) bool {
+		l, r := ptxs[i].Fee*int64(ptxs[j].Size), ptxs[j].Fee*int64(ptxs[i].Size)
+		if l != r {
+			return l > r
+		}
+		return ptxs[i].Hash < ptxs[j].Hash
+	})
+	selected := make(map[string]struct{})
+	txs := make(transaction.TxSlice, 0)
+	var fees int64
+	for added := true; added; {
+		added = false
+		for _, ptx := range ptxs {
+			if _, ok := selected[ptx.Hash]; ok {
+				continue
+			}
+			if bc.MaxBlockTxs > 0 && count+1 > bc.MaxBlockTxs {
+				return txs, fees, nil
+			}
+			if bc.MaxBlockSize > 0 && size+ptx.Size > bc.MaxBlockSize {
+				continue
+			}
+			if !hasSelectedParents(bc, ptx.Tx, selected) {
+				continue
+			}
+			selected[ptx.Hash] = struct{}{}
+			txs = append(txs, ptx.Tx)
+			fees += ptx.Fee
+			size += ptx.Size
+			count++
+			added = true
+		}
+	}
+	return txs, fees, nil
+}
+
+func hasSelectedParents(bc *Blockchain, tx transaction.Tx, selected map[string]struct{}) bool {
+	for _, txin := range tx.TxIns {
+		parent := txin.PreviousOutPoint.TxHash
+		if _, ok := bc.PendingTxs[parent]; !ok {
+			continue
+		}
+		if _, ok := selected[parent]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func removePendingTx(bc *Blockchain, h string) error {
+	tx, ok := bc.PendingTxs[h]
+	if !ok {
+		return nil
+	}
+	for _, txin := range tx.TxIns {
+		utxoh, err := transaction.GenerateOutPointHash(txin.PreviousOutPoint)
+		if err != nil {
+			return err
+		}
+		delete(bc.PendingSpends, utxoh)
+	}
+	for i, txout := range tx.TxOuts {
+		utxoh, err := transaction.GenerateUTxOHash(transaction.GenerateUTxOFromTxOut(h, i, txout))
+		if err != nil {
+			return err
+		}
+		delete(bc.PendingUTxOs, utxoh)
+	}
+	delete(bc.PendingTxs, h)
+	emit(bc, Event{Type: TxRemoved, Hash: h, Tx: tx})
+	return nil
+}
+
+func evictConflictingPendingTxs(bc *Blockchain) error {
+	for evicted := true; evicted; {
+		evicted = false
+		for h, tx := range bc.PendingTxs {
+			for _, txin := range tx.TxIns {
+				utxoh, err := transaction.GenerateOutPointHash(txin.PreviousOutPoint)
+				if err != nil {
+					return err
+				}
+				_, unspent := bc.UTxOs[utxoh]
+				_, pending := bc.PendingUTxOs[utxoh]
+				if unspent || pending {
+					continue
+				}
+				if err := removePendingTx(bc, h); err != nil {
+					return err
+				}
+				evicted = true
+				break
+			}
+		}
+	}
+	return nil
+}
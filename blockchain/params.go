@@ -0,0 +1,33 @@
+package blockchain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"github.com/guiferpa/jackiechain/codec"
+)
+
+var ErrConsensusParamsMismatch = errors.New("consensus params don't match")
+
+// GenerateConsensusParamsHash commits to every setting that decides whether a
+// block is valid, so peers running different rules can refuse each other up
+// front instead of rejecting each other's blocks.
+func GenerateConsensusParamsHash(bc *Blockchain) (string, error) {
+	w := codec.NewWriter()
+	w.Uint8(bc.NetworkVersion)
+	w.Uint32(bc.MiningBits)
+	w.Int64(bc.BlockVersion2Height)
+	w.Int64(int64(bc.TargetBlockTime))
+	w.Int64(bc.RetargetInterval)
+	w.Int64(bc.InitialSubsidy)
+	w.Int64(bc.HalvingInterval)
+	w.Len(bc.MaxBlockTxs)
+	w.Len(bc.MaxBlockSize)
+	bs, err := w.Bytes()
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(bs)
+	return hex.EncodeToString(h[:]), nil
+}
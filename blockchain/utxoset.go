@@ -0,0 +1,110 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/guiferpa/jackiechain/block"
+	"github.com/guiferpa/jackiechain/merkletree"
+	"github.com/guiferpa/jackiechain/transaction"
+)
+
+var ErrInvalidUTxOSetRoot = errors.New("block utxo set root doesn't match the resulting utxo set")
+
+func putUTxOLeaf(tree *merkletree.SparseTree, utxo transaction.UTxO) error {
+	utxoh, err := transaction.GenerateUTxOHash(utxo)
+	if err != nil {
+		return err
+	}
+	v, err := transaction.GenerateUTxOValueHash(utxo)
+	if err != nil {
+		return err
+	}
+	return tree.Update(utxoh, v)
+}
+
+func generateUTxOTree(utxos transaction.UTxOMap) (*merkletree.SparseTree, error) {
+	leaves := make(map[string]string, len(utxos))
+	for utxoh, utxo := range utxos {
+		v, err := transaction.GenerateUTxOValueHash(utxo)
+		if err != nil {
+			return nil, err
+		}
+		leaves[utxoh] = v
+	}
+	return merkletree.NewSparseTreeFromMap(leaves)
+}
+
+func getUTxOTree(bc *Blockchain) (*merkletree.SparseTree, error) {
+	if bc.utxoTree == nil {
+		tree, err := generateUTxOTree(bc.UTxOs)
+		if err != nil {
+			return nil, err
+		}
+		bc.utxoTree = tree
+	}
+	return bc.utxoTree, nil
+}
+
+func applyTxToUTxOTree(tree *merkletree.SparseTree, tx transaction.Tx) error {
+	txh, err := transaction.GenerateTxHash(tx)
+	if err != nil {
+		return err
+	}
+	for _, txin := range tx.TxIns {
+		utxoh, err := transaction.GenerateOutPointHash(txin.PreviousOutPoint)
+		if err != nil {
+			return err
+		}
+		if err := tree.Delete(utxoh); err != nil {
+			return err
+		}
+	}
+	for i, txout := range tx.TxOuts {
+		if err := putUTxOLeaf(tree, transaction.GenerateUTxOFromTxOut(txh, i, txout)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func generateUTxOSetRootHash(bc *Blockchain, txs transaction.TxSlice) (string, error) {
+	tree, err := getUTxOTree(bc)
+	if err != nil {
+		return "", err
+	}
+	tree = tree.Clone()
+	for _, tx := range txs {
+		if err := applyTxToUTxOTree(tree, tx); err != nil {
+			return "", err
+		}
+	}
+	return tree.Root(), nil
+}
+
+func ValidateUTxOSetRoot(bc *Blockchain, b block.Block) error {
+	root, err := generateUTxOSetRootHash(bc, b.Transactions)
+	if err != nil {
+		return err
+	}
+	if root != b.Header.UTxOSetRootHash {
+		return fmt.Errorf("%w: expected %s, got %s", ErrInvalidUTxOSetRoot, root, b.Header.UTxOSetRootHash)
+	}
+	return nil
+}
+
+func GetUTxOProof(bc *Blockchain, utxoh string) (string, *merkletree.SparseProof, error) {
+	tip, err := getTip(bc)
+	if err != nil {
+		return "", nil, err
+	}
+	tree, err := getUTxOTree(bc)
+	if err != nil {
+		return "", nil, err
+	}
+	p, err := tree.Proof(utxoh)
+	if err != nil {
+		return "", nil, err
+	}
+	return tip.Hash, p, nil
+}
@@ -0,0 +1,437 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/guiferpa/jackiechain/address"
+	"github.com/guiferpa/jackiechain/block"
+	"github.com/guiferpa/jackiechain/merkletree"
+	"github.com/guiferpa/jackiechain/store"
+	"github.com/guiferpa/jackiechain/transaction"
+)
+
+const (
+	MedianTimeSpan     = 11
+	MaxFutureBlockTime = 2 * time.Hour
+)
+
+var GenesisPreviousBlockHash = strings.Repeat("0", 64)
+
+var (
+	ErrBlockAlreadyKnown    = errors.New("block is already known")
+	ErrInvalidBlock         = errors.New("block is invalid")
+	ErrInvalidProofOfWork   = errors.New("block hash doesn't meet the mining difficulty")
+	ErrInvalidMerkleRoot    = errors.New("block merkle root doesn't match its transactions")
+	ErrUnknownPreviousBlock = errors.New("block previous hash is unknown")
+	ErrPreviousBlockNotTip  = errors.New("block doesn't extend the chain tip")
+	ErrInvalidDifficulty    = errors.New("block difficulty doesn't follow the retarget rules")
+	ErrInvalidHeight        = errors.New("block height doesn't follow its previous block")
+	ErrInvalidTimestamp     = errors.New("block timestamp is out of range")
+	ErrInvalidTxSignature   = errors.New("block has a tx with an invalid signature")
+	ErrInvalidCoinbase      = errors.New("block has an invalid coinbase")
+	ErrUnknownUTxO          = errors.New("tx references an unknown utxo")
+	ErrInvalidTxOrder       = errors.New("block txs aren't in a valid order")
+	ErrInvalidTxOutput      = errors.New("tx has an invalid output")
+)
+
+func ValidateProofOfWork(bc *Blockchain, b block.Block) error {
+	return ValidateHeaderProofOfWork(bc, b.Header)
+}
+
+func ValidateHeaderProofOfWork(bc *Blockchain, bh block.BlockHeader) error {
+	h, err := block.GenerateBlockHeaderHash(bh)
+	if err != nil {
+		return err
+	}
+	target := block.CompactToBig(bh.Bits)
+	if target.Sign() <= 0 || target.Cmp(block.CompactToBig(bc.MiningBits)) > 0 {
+		return fmt.Errorf("%w: bits %08x is out of range", ErrInvalidDifficulty, bh.Bits)
+	}
+	n, ok := block.HashToBig(h)
+	if !ok || n.Cmp(target) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidProofOfWork, h)
+	}
+	return nil
+}
+
+func generateMerkleTree(version string, txs transaction.TxSlice) (*merkletree.Tree, error) {
+	v, err := getMerkleTreeVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	wtxhs := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		wtxh, err := transaction.GenerateWitnessHash(tx)
+		if err != nil {
+			return nil, err
+		}
+		wtxhs = append(wtxhs, wtxh)
+	}
+	return merkletree.New(v, wtxhs)
+}
+
+func generateMerkleRootHash(version string, txs transaction.TxSlice) (string, error) {
+	tree, err := generateMerkleTree(version, txs)
+	if err != nil {
+		return "", err
+	}
+	return tree.Root(), nil
+}
+
+func ValidateMerkleRoot(b block.Block) error {
+	root, err := generateMerkleRootHash(b.Header.Version, b.Transactions)
+	if err != nil {
+		return err
+	}
+	if root != b.Header.MerkleTreeRootHash {
+		return fmt.Errorf("%w: expected %s, got %s", ErrInvalidMerkleRoot, root, b.Header.MerkleTreeRootHash)
+	}
+	return nil
+}
+
+func ValidateTxOrder(b block.Block) error {
+	if len(b.Transactions) == 0 || !b.Transactions[0].IsCoinbase() {
+		return fmt.Errorf("%w: first tx isn't a coinbase", ErrInvalidTxOrder)
+	}
+	txhs, err := b.Transactions.GenerateTxHashes()
+	if err != nil {
+		return err
+	}
+	pos := make(map[string]int, len(txhs))
+	for i, h := range txhs {
+		if _, ok := pos[h]; ok {
+			return fmt.Errorf("%w: duplicate tx %s", ErrInvalidTxOrder, h)
+		}
+		pos[h] = i
+	}
+	for i, tx := range b.Transactions {
+		if i > 0 && tx.IsCoinbase() {
+			return fmt.Errorf("%w: tx %s is a coinbase after the first position", ErrInvalidTxOrder, txhs[i])
+		}
+		for _, txin := range tx.TxIns {
+			if j, ok := pos[txin.PreviousOutPoint.TxHash]; ok && j >= i {
+				return fmt.Errorf("%w: tx %s spends tx %s placed after it", ErrInvalidTxOrder, txhs[i], txin.PreviousOutPoint.TxHash)
+			}
+		}
+	}
+	return nil
+}
+
+func ValidatePreviousBlock(bc *Blockchain, bh block.BlockHeader) error {
+	if bh.PreviousBlockHash == GenesisPreviousBlockHash {
+		if bh.Height != 0 {
+			return fmt.Errorf("%w: expected 0, got %d", ErrInvalidHeight, bh.Height)
+		}
+		return nil
+	}
+	parent, err := bc.Store.GetBlock(bh.PreviousBlockHash)
+	if errors.Is(err, store.ErrNotFound) {
+		return fmt.Errorf("%w: %s", ErrUnknownPreviousBlock, bh.PreviousBlockHash)
+	}
+	if err != nil {
+		return err
+	}
+	if bh.Height != parent.Header.Height+1 {
+		return fmt.Errorf("%w: expected %d, got %d", ErrInvalidHeight, parent.Header.Height+1, bh.Height)
+	}
+	return nil
+}
+
+func medianTimePast(bc *Blockchain, h string) (int64, error) {
+	ts := make([]int64, 0, MedianTimeSpan)
+	for len(ts) < MedianTimeSpan {
+		b, err := bc.Store.GetBlock(h)
+		if errors.Is(err, store.ErrNotFound) {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		ts = append(ts, b.Header.Timestamp)
+		h = b.Header.PreviousBlockHash
+	}
+	if len(ts) == 0 {
+		return 0, nil
+	}
+	sort.Slice(ts, func(i, j int) bool { return ts[i] < ts[j] })
+	return ts[len(ts)/2], nil
+}
+
+func ValidateTimestamp(bc *Blockchain, bh block.BlockHeader) error {
+	mtp, err := medianTimePast(bc, bh.PreviousBlockHash)
+	if err != nil {
+		return err
+	}
+	if bh.Timestamp <= mtp {
+		return fmt.Errorf("%w: %d isn't after median time past %d", ErrInvalidTimestamp, bh.Timestamp, mtp)
+	}
+	if limit := time.Now().Add(MaxFutureBlockTime).UnixMilli(); bh.Timestamp > limit {
+		return fmt.Errorf("%w: %d is too far in the future", ErrInvalidTimestamp, bh.Timestamp)
+	}
+	return nil
+}
+
+func blockOutputs(b block.Block) (transaction.UTxOMap, error) {
+	created := make(transaction.UTxOMap)
+	txhs, err := b.Transactions.GenerateTxHashes()
+	if err != nil {
+		return nil, err
+	}
+	for i, tx := range b.Transactions {
+		h := txhs[i]
+		for i, txout := range tx.TxOuts {
+			utxo := transaction.GenerateUTxOFromTxOut(h, i, txout)
+			utxoh, err := transaction.GenerateUTxOHash(utxo)
+			if err != nil {
+				return nil, err
+			}
+			created[utxoh] = utxo
+		}
+	}
+	return created, nil
+}
+
+func validateTxOutputs(bc *Blockchain, tx transaction.Tx) error {
+	for i, txout := range tx.TxOuts {
+		if txout.Value < 0 || txout.Value == 0 && !tx.IsCoinbase() {
+			return fmt.Errorf("%w: output %d has no positive value", ErrInvalidTxOutput, i)
+		}
+		if txout.Value > transaction.MaxMoney {
+			return fmt.Errorf("%w: output %d value %d exceeds %d", ErrInvalidTxOutput, i, txout.Value, transaction.MaxMoney)
+		}
+		if err := address.ValidateNetworkAddress(txout.Receiver, bc.NetworkVersion); err != nil {
+			return fmt.Errorf("%w: output %d: %w", ErrInvalidTxOutput, i, err)
+		}
+	}
+	return nil
+}
+
+func ValidateTxOutputs(bc *Blockchain, b block.Block) error {
+	txhs, err := b.Transactions.GenerateTxHashes()
+	if err != nil {
+		return err
+	}
+	for i, tx := range b.Transactions {
+		if err := validateTxOutputs(bc, tx); err != nil {
+			return fmt.Errorf("tx %s: %w", txhs[i], err)
+		}
+	}
+	return nil
+}
+
+func ValidateTxSignatures(bc *Blockchain, b block.Block) error {
+	created, err := blockOutputs(b)
+	if err != nil {
+		return err
+	}
+	txhs, err := b.Transactions.GenerateTxHashes()
+	if err != nil {
+		return err
+	}
+	for i, tx := range b.Transactions {
+		h := txhs[i]
+		if tx.IsCoinbase() {
+			continue
+		}
+		for i, txin := range tx.TxIns {
+			utxoh, err := transaction.GenerateOutPointHash(txin.PreviousOutPoint)
+			if err != nil {
+				return err
+			}
+			utxo, ok := bc.UTxOs[utxoh]
+			if !ok {
+				utxo, ok = created[utxoh]
+			}
+			if !ok {
+				return fmt.Errorf("%w: tx %s input %d", ErrUnknownUTxO, h, i)
+			}
+			owned, err := address.IsOwnedBy(utxo.Receiver, txin.PublicKey)
+			if err != nil {
+				return err
+			}
+			if !owned {
+				return fmt.Errorf("%w: tx %s input %d doesn't own utxo %s", ErrInvalidTxSignature, h, i, utxoh)
+			}
+			has, err := transaction.TxInHasValidSignature(tx, i)
+			if err != nil {
+				return err
+			}
+			if !has {
+				return fmt.Errorf("%w: tx %s input %d", ErrInvalidTxSignature, h, i)
+			}
+		}
+	}
+	return nil
+}
+
+func ValidateBlockSpends(bc *Blockchain, b block.Block) error {
+	created, err := blockOutputs(b)
+	if err != nil {
+		return err
+	}
+	spent := make(map[string]string)
+	txhs, err := b.Transactions.GenerateTxHashes()
+	if err != nil {
+		return err
+	}
+	for i, tx := range b.Transactions {
+		h := txhs[i]
+		for _, txin := range tx.TxIns {
+			utxoh, err := transaction.GenerateOutPointHash(txin.PreviousOutPoint)
+			if err != nil {
+				return err
+			}
+			if by, ok := spent[utxoh]; ok {
+				return &DoubleSpendError{UTxOHash: utxoh, TxHash: h, SpentBy: by}
+			}
+			_, unspent := bc.UTxOs[utxoh]
+			_, inblock := created[utxoh]
+			if !unspent && !inblock {
+				spentonchain, err := isSpentOnChain(bc, txin.PreviousOutPoint)
+				if err != nil {
+					return err
+				}
+				if spentonchain {
+					return &DoubleSpendError{UTxOHash: utxoh, TxHash: h}
+				}
+				return fmt.Errorf("%w: tx %s utxo %s", ErrUnknownUTxO, h, utxoh)
+			}
+			spent[utxoh] = h
+		}
+	}
+	return nil
+}
+
+func ValidateCoinbase(bc *Blockchain, b block.Block, height int64) error {
+	var coinbase *transaction.Tx
+	for _, tx := range b.Transactions {
+		if !tx.IsCoinbase() {
+			continue
+		}
+		if coinbase != nil {
+			return fmt.Errorf("%w: more than one coinbase tx", ErrInvalidCoinbase)
+		}
+		coinbase = &tx
+	}
+	if coinbase == nil {
+		return fmt.Errorf("%w: no coinbase tx", ErrInvalidCoinbase)
+	}
+	if coinbase.Height != height {
+		return fmt.Errorf("%w: commits to height %d, not %d", ErrInvalidCoinbase, coinbase.Height, height)
+	}
+	created, err := blockOutputs(b)
+	if err != nil {
+		return err
+	}
+	var fees int64
+	txhs, err := b.Transactions.GenerateTxHashes()
+	if err != nil {
+		return err
+	}
+	for i, tx := range b.Transactions {
+		h := txhs[i]
+		if tx.IsCoinbase() {
+			continue
+		}
+		var inputs int64
+		for _, txin := range tx.TxIns {
+			utxoh, err := transaction.GenerateOutPointHash(txin.PreviousOutPoint)
+			if err != nil {
+				return err
+			}
+			utxo, ok := bc.UTxOs[utxoh]
+			if !ok {
+				utxo, ok = created[utxoh]
+			}
+			if !ok {
+				return fmt.Errorf("%w: tx %s utxo %s", ErrUnknownUTxO, h, utxoh)
+			}
+			if inputs, err = transaction.AddMoney(inputs, utxo.Value); err != nil {
+				return fmt.Errorf("tx %s inputs: %w", h, err)
+			}
+		}
+		outputs, err := tx.OutputsValue()
+		if err != nil {
+			return fmt.Errorf("tx %s outputs: %w", h, err)
+		}
+		if inputs < outputs {
+			return fmt.Errorf("tx %s outputs exceed its inputs", h)
+		}
+		if fees, err = transaction.AddMoney(fees, inputs-outputs); err != nil {
+			return fmt.Errorf("tx %s fee: %w", h, err)
+		}
+	}
+	for i, txout := range coinbase.TxOuts {
+		if txout.Value < 0 {
+			return fmt.Errorf("%w: output %d has negative value", ErrInvalidCoinbase, i)
+		}
+	}
+	outputs, err := coinbase.OutputsValue()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidCoinbase, err)
+	}
+	reward, err := transaction.AddMoney(GetBlockSubsidy(bc, height), fees)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidCoinbase, err)
+	}
+	if outputs > reward {
+		return fmt.Errorf("%w: pays %d, more than the allowed reward %d", ErrInvalidCoinbase, outputs, reward)
+	}
+	return nil
+}
+
+func ValidateHeader(bc *Blockchain, bh block.BlockHeader) error {
+	if err := ValidateHeaderProofOfWork(bc, bh); err != nil {
+		return err
+	}
+	if err := ValidatePreviousBlock(bc, bh); err != nil {
+		return err
+	}
+	if err := ValidateVersion(bc, bh); err != nil {
+		return err
+	}
+	bits, err := GetNextBits(bc, bh.PreviousBlockHash)
+	if err != nil {
+		return err
+	}
+	if bh.Bits != bits {
+		return fmt.Errorf("%w: expected %08x, got %08x", ErrInvalidDifficulty, bits, bh.Bits)
+	}
+	return ValidateTimestamp(bc, bh)
+}
+
+func ValidateBlock(bc *Blockchain, b block.Block) error {
+	if err := ValidateHeader(bc, b.Header); err != nil {
+		return err
+	}
+	if err := ValidateMerkleRoot(b); err != nil {
+		return err
+	}
+	if err := ValidateTxOrder(b); err != nil {
+		return err
+	}
+	tip, err := getTip(bc)
+	if err != nil {
+		return err
+	}
+	if b.Header.PreviousBlockHash != tip.Hash {
+		return fmt.Errorf("%w: %s", ErrPreviousBlockNotTip, b.Header.PreviousBlockHash)
+	}
+	if err := ValidateTxOutputs(bc, b); err != nil {
+		return err
+	}
+	if err := ValidateBlockSpends(bc, b); err != nil {
+		return err
+	}
+	if err := ValidateTxSignatures(bc, b); err != nil {
+		return err
+	}
+	if err := ValidateCoinbase(bc, b, b.Header.Height); err != nil {
+		return err
+	}
+	return ValidateUTxOSetRoot(bc, b)
+}
@@ -0,0 +1,30 @@
+package blockchain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/guiferpa/jackiechain/block"
+)
+
+func TestValidateBlockReportsOnChainDoubleSpend(t *testing.T) {
+	miner, other := newTestKey(t), newTestKey(t)
+	bc := newTestChain()
+	_, genesis := mineTestBlock(t, bc, miner.address)
+	utxo := coinbaseUTxO(t, genesis)
+	if err := AddTx(bc, newTestSpend(t, miner, utxo, other.address)); err != nil {
+		t.Fatal(err)
+	}
+	mineTestBlock(t, bc, miner.address)
+
+	_, b := newTestBlock(t, bc, miner.address, func(b *block.Block) {
+		b.Transactions = append(b.Transactions, newTestSpend(t, miner, utxo, miner.address))
+	})
+	var dserr *DoubleSpendError
+	if err := ValidateBlock(bc, b); !errors.As(err, &dserr) {
+		t.Fatalf("got error %v, want a *DoubleSpendError", err)
+	}
+	if dserr.UTxOHash == "" || dserr.SpentBy != "" {
+		t.Fatalf("got %+v, want an on-chain double spend", dserr)
+	}
+}
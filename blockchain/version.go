@@ -0,0 +1,40 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/guiferpa/jackiechain/block"
+	"github.com/guiferpa/jackiechain/merkletree"
+)
+
+const (
+	BlockVersion1 = "1"
+	BlockVersion2 = "2"
+)
+
+var ErrInvalidBlockVersion = errors.New("block version isn't valid at its height")
+
+func GetBlockVersion(bc *Blockchain, height int64) string {
+	if height >= bc.BlockVersion2Height {
+		return BlockVersion2
+	}
+	return BlockVersion1
+}
+
+func getMerkleTreeVersion(version string) (merkletree.Version, error) {
+	switch version {
+	case BlockVersion1:
+		return merkletree.V1, nil
+	case BlockVersion2:
+		return merkletree.V2, nil
+	}
+	return 0, fmt.Errorf("%w: unknown version %q", ErrInvalidBlockVersion, version)
+}
+
+func ValidateVersion(bc *Blockchain, bh block.BlockHeader) error {
+	if version := GetBlockVersion(bc, bh.Height); bh.Version != version {
+		return fmt.Errorf("%w: expected %s, got %s", ErrInvalidBlockVersion, version, bh.Version)
+	}
+	return nil
+}
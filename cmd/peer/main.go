@@ -1,36 +1,111 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
+	"io/fs"
 	"net"
 	"os"
+	"runtime"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/guiferpa/jackiechain/block"
+	"github.com/guiferpa/jackiechain/address"
 	"github.com/guiferpa/jackiechain/blockchain"
 	"github.com/guiferpa/jackiechain/logger"
+	"github.com/guiferpa/jackiechain/miner"
 	"github.com/guiferpa/jackiechain/peer"
+	"github.com/guiferpa/jackiechain/store"
 	"github.com/guiferpa/jackiechain/transaction"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"github.com/guiferpa/jackiechain/wallet"
 )
 
+const PassphraseEnv = "JACKIECHAIN_PASSPHRASE"
+
+func openKeystore(path string, passphrase []byte) (*wallet.Wallet, error) {
+	if len(passphrase) == 0 {
+		return nil, fmt.Errorf("keystore %s needs a passphrase in $%s", path, PassphraseEnv)
+	}
+	w, err := wallet.LoadKeystore(path, passphrase)
+	if !errors.Is(err, fs.ErrNotExist) {
+		return w, err
+	}
+	if w, err = wallet.NewWallet(); err != nil {
+		return nil, err
+	}
+	if err := wallet.SaveKeystore(path, w, passphrase); err != nil {
+		return nil, err
+	}
+	logger.Yellow(fmt.Sprintf("Created keystore %s for wallet %s", path, w.GetAddress()))
+	return w, nil
+}
+
 func main() {
 	serverPort := flag.Int("server-port", 9000, "server port")
 	nodeRemote := flag.String("node-remote", "", "node remote (no standalone config)")
+	minerAddress := flag.String("miner-address", "", "address to receive mining rewards")
+	dataDir := flag.String("data-dir", "", "chain data directory (in-memory when empty)")
+	minerWorkers := flag.Int("miner-workers", runtime.NumCPU(), "number of mining goroutines")
+	keystorePath := flag.String("keystore", "", "encrypted miner wallet file, unlocked with $"+PassphraseEnv)
+	targetBlockTime := flag.Duration("target-block-time", 10*time.Second, "block time the difficulty retargets towards")
+	retargetInterval := flag.Int64("retarget-interval", 20, "blocks between difficulty retargets (0 disables retargeting)")
+	initialSubsidy := flag.Int64("initial-subsidy", 50, "coinbase subsidy before the first halving")
+	halvingInterval := flag.Int64("halving-interval", 210000, "blocks between subsidy halvings (0 disables halving)")
+	maxBlockTxs := flag.Int("max-block-txs", 1000, "max txs per block including the coinbase (0 means unlimited)")
+	maxBlockSize := flag.Int("max-block-size", 1<<20, "max encoded txs bytes per block (0 means unlimited)")
 
 	flag.Parse()
 
+	if !transaction.IsMoneyRange(*initialSubsidy) {
+		logger.Red(fmt.Sprintf("initial subsidy %d must be between 0 and %d", *initialSubsidy, transaction.MaxMoney))
+		return
+	}
+	if *targetBlockTime <= 0 {
+		logger.Red(fmt.Sprintf("target block time %s must be positive", *targetBlockTime))
+		return
+	}
+
+	var s store.Store = store.NewMemory()
+	if *dataDir != "" {
+		bs, err := store.NewBolt(*dataDir)
+		if err != nil {
+			logger.Red(err.Error())
+			return
+		}
+		s = bs
+	}
+	defer s.Close()
+
 	bc := &blockchain.Blockchain{
-		Blocks:           make(block.BlockMap),
-		Txs:              make(transaction.TxMap),
-		PendingTxs:       make(transaction.TxMap),
-		MiningDifficulty: 4,
-		UTxOs:            make(transaction.UTxOMap),
-		GenesisBlock:     nil,
-		LatestBlock:      nil,
+		Store:               s,
+		PendingTxs:          make(transaction.TxMap),
+		PendingUTxOs:        make(transaction.UTxOMap),
+		PendingSpends:       make(map[string]string),
+		NetworkVersion:      address.MainNetVersion,
+		MiningBits:          0x1f00ffff,
+		BlockVersion2Height: 0,
+		TargetBlockTime:     *targetBlockTime,
+		RetargetInterval:    *retargetInterval,
+		InitialSubsidy:      *initialSubsidy,
+		HalvingInterval:     *halvingInterval,
+		MaxBlockTxs:         *maxBlockTxs,
+		MaxBlockSize:        *maxBlockSize,
+		UTxOs:               make(transaction.UTxOMap),
+		GenesisBlock:        nil,
+		LatestBlock:         nil,
+	}
+	if err := blockchain.Restore(bc); err != nil {
+		logger.Red(err.Error())
+		return
+	}
+	bc.Subscribe(func(ev blockchain.Event) {
+		if ev.Type == blockchain.BlockDisconnected {
+			logger.Yellow(fmt.Sprintf("Block %s was disconnected from the chain", ev.Hash))
+		}
+	})
+	if tip, err := s.GetTip(); err == nil && tip.Height >= 0 {
+		logger.Magenta(fmt.Sprintf("Resuming chain from block %s at height %v", tip.Hash, tip.Height))
 	}
 
 	peerID := uuid.New().String()
@@ -38,6 +113,40 @@ func main() {
 	logger.Magenta(fmt.Sprintf("Initializing peer %s", peerID))
 
 	p := peer.New(peer.ID(peerID), bc)
+	p.ServerPort = *serverPort
+	p.Miner = miner.New(*minerWorkers)
+
+	if *minerAddress == "" && *keystorePath != "" {
+		w, err := openKeystore(*keystorePath, []byte(os.Getenv(PassphraseEnv)))
+		if err != nil {
+			logger.Red(err.Error())
+			return
+		}
+		*minerAddress = w.GetAddress()
+	}
+	if *minerAddress == "" {
+		logger.Red("peer needs -miner-address or -keystore to receive mining rewards")
+		return
+	}
+	if err := address.ValidateNetworkAddress(*minerAddress, bc.NetworkVersion); err != nil {
+		logger.Red(err.Error())
+		return
+	}
+	p.MinerAddress = *minerAddress
+	logger.Magenta(fmt.Sprintf("Mining rewards go to %s", p.MinerAddress))
+
+	tracker, err := wallet.NewTracker(bc, p.MinerAddress)
+	if err != nil {
+		logger.Red(err.Error())
+		return
+	}
+	bc.Subscribe(func(ev blockchain.Event) {
+		if ev.Type != blockchain.BlockConnected {
+			return
+		}
+		balance := tracker.Balance()
+		logger.Magenta(fmt.Sprintf("Miner balance: %v confirmed, %v unconfirmed", balance.Confirmed, balance.Unconfirmed))
+	})
 
 	listener, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%v", *serverPort))
 	if err != nil {
@@ -51,20 +160,19 @@ func main() {
 	<-serving
 	logger.Magenta(fmt.Sprintf("Running gRPC server on port %v", *serverPort))
 
-	go p.SetBuildBlockInterval(time.NewTicker(time.Second * 5))
-
 	if *nodeRemote != "" {
-		conn, err := grpc.NewClient(*nodeRemote, grpc.WithTransportCredentials(insecure.NewCredentials()))
-		if err != nil {
+		if err := p.TryConnect(peer.Remote(*nodeRemote)); err != nil {
 			logger.Red(err.Error())
-			os.Exit(2)
+			os.Exit(3)
 		}
-		if err := p.TryConnect(conn); err != nil {
+		if err := p.Sync(peer.Remote(*nodeRemote)); err != nil {
 			logger.Red(err.Error())
-			os.Exit(3)
+			os.Exit(4)
 		}
 	}
 
+	go p.SetBuildBlockInterval(time.NewTicker(time.Second * 5))
+
 	err = <-cherr
 	logger.Red(err.Error())
 	os.Exit(1)
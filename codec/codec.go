@@ -0,0 +1,187 @@
+package codec
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const Version byte = 1
+
+var (
+	ErrShortBuffer     = errors.New("unexpected end of encoded data")
+	ErrTrailingBytes   = errors.New("trailing bytes after encoded data")
+	ErrUnknownVersion  = errors.New("unknown encoding version")
+	ErrFieldTooLong    = errors.New("encoded field is too long")
+	ErrNegativeInteger = errors.New("negative integer can't be encoded as unsigned")
+)
+
+type Writer struct {
+	buf []byte
+	err error
+}
+
+func NewWriter() *Writer {
+	w := &Writer{}
+	w.Uint8(Version)
+	return w
+}
+
+func (w *Writer) Uint8(v uint8) {
+	w.buf = append(w.buf, v)
+}
+
+func (w *Writer) Uint32(v uint32) {
+	w.buf = binary.BigEndian.AppendUint32(w.buf, v)
+}
+
+func (w *Writer) Uint64(v uint64) {
+	w.buf = binary.BigEndian.AppendUint64(w.buf, v)
+}
+
+func (w *Writer) Int64(v int64) {
+	w.Uint64(uint64(v))
+}
+
+func (w *Writer) Len(n int) {
+	if n < 0 {
+		w.fail(ErrNegativeInteger)
+		return
+	}
+	if n > math.MaxUint32 {
+		w.fail(ErrFieldTooLong)
+		return
+	}
+	w.Uint32(uint32(n))
+}
+
+func (w *Writer) VarBytes(bs []byte) {
+	w.Len(len(bs))
+	w.buf = append(w.buf, bs...)
+}
+
+func (w *Writer) String(s string) {
+	w.VarBytes([]byte(s))
+}
+
+func (w *Writer) fail(err error) {
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+func (w *Writer) Bytes() ([]byte, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+	return w.buf, nil
+}
+
+type Reader struct {
+	buf []byte
+	err error
+}
+
+func NewReader(bs []byte) *Reader {
+	r := &Reader{buf: bs}
+	if v := r.Uint8(); r.err == nil && v != Version {
+		r.fail(fmt.Errorf("%w: %d", ErrUnknownVersion, v))
+	}
+	return r
+}
+
+func (r *Reader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n > len(r.buf) {
+		r.fail(ErrShortBuffer)
+		return nil
+	}
+	bs := r.buf[:n]
+	r.buf = r.buf[n:]
+	return bs
+}
+
+func (r *Reader) Uint8() uint8 {
+	bs := r.next(1)
+	if bs == nil {
+		return 0
+	}
+	return bs[0]
+}
+
+func (r *Reader) Uint32() uint32 {
+	bs := r.next(4)
+	if bs == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(bs)
+}
+
+func (r *Reader) Uint64() uint64 {
+	bs := r.next(8)
+	if bs == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bs)
+}
+
+func (r *Reader) Int64() int64 {
+	return int64(r.Uint64())
+}
+
+func (r *Reader) Len() int {
+	n := r.Uint32()
+	if r.err == nil && uint64(n) > uint64(len(r.buf)) {
+		r.fail(ErrShortBuffer)
+		return 0
+	}
+	return int(n)
+}
+
+// Count reads an element count and fails unless the remaining bytes could
+// hold that many elements of at least size bytes each, so a forged count
+// can't make decoders allocate more than the input justifies.
+func (r *Reader) Count(size int) int {
+	n := r.Uint32()
+	if r.err == nil && uint64(n)*uint64(max(size, 1)) > uint64(len(r.buf)) {
+		r.fail(ErrShortBuffer)
+		return 0
+	}
+	return int(n)
+}
+
+func (r *Reader) VarBytes() []byte {
+	n := r.Len()
+	bs := r.next(n)
+	if bs == nil {
+		return nil
+	}
+	return append([]byte(nil), bs...)
+}
+
+func (r *Reader) String() string {
+	return string(r.VarBytes())
+}
+
+func (r *Reader) fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+func (r *Reader) Err() error {
+	return r.err
+}
+
+func (r *Reader) Close() error {
+	if r.err != nil {
+		return r.err
+	}
+	if len(r.buf) != 0 {
+		return ErrTrailingBytes
+	}
+	return nil
+}
@@ -8,16 +8,17 @@ require (
 	github.com/fatih/color v1.15.0
 	github.com/google/uuid v1.6.0
 	github.com/mr-tron/base58 v1.2.0
+	go.etcd.io/bbolt v1.3.11
+	golang.org/x/crypto v0.27.0
+	golang.org/x/text v0.18.0
 	google.golang.org/grpc v1.68.1
 	google.golang.org/protobuf v1.35.2
 )
 
 require (
-	github.com/golang/protobuf v1.5.4 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.18 // indirect
 	golang.org/x/net v0.29.0 // indirect
 	golang.org/x/sys v0.25.0 // indirect
-	golang.org/x/text v0.18.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240903143218-8af14fe29dc1 // indirect
 )
@@ -3,9 +3,45 @@ package merkletree
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 )
 
+type Version int
+
+const (
+	V1 Version = iota + 1
+	V2
+)
+
+const (
+	leafPrefix byte = 0x00
+	nodePrefix byte = 0x01
+)
+
+var (
+	ErrLeafOutOfRange  = errors.New("merkle leaf index out of range")
+	ErrUnknownVersion  = errors.New("unknown merkle tree version")
+	ErrInvalidLeafHash = errors.New("merkle leaf isn't a hex encoded hash")
+)
+
+type Tree struct {
+	version Version
+	leaves  []string
+	levels  [][]string
+}
+
+type ProofStep struct {
+	Hash string `json:"hash"`
+	Left bool   `json:"left"`
+}
+
+type Proof struct {
+	Index int         `json:"index"`
+	Leaf  string      `json:"leaf"`
+	Path  []ProofStep `json:"path"`
+}
+
 func generateLeafHash(hl string, hr string) string {
 	payload := fmt.Sprintf("%s%s", hl, hr)
 	h := sha256.New()
@@ -13,26 +49,122 @@ func generateLeafHash(hl string, hr string) string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
-func GenerateRootHash(hs []string) string {
-	if len(hs) == 0 {
+func hashPrefixed(prefix byte, hs ...string) (string, error) {
+	h := sha256.New()
+	h.Write([]byte{prefix})
+	for _, s := range hs {
+		bs, err := hex.DecodeString(s)
+		if err != nil || len(bs) != sha256.Size {
+			return "", fmt.Errorf("%w: %q", ErrInvalidLeafHash, s)
+		}
+		h.Write(bs)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func hashLeaf(v Version, leaf string) (string, error) {
+	switch v {
+	case V1:
+		return leaf, nil
+	case V2:
+		return hashPrefixed(leafPrefix, leaf)
+	}
+	return "", fmt.Errorf("%w: %d", ErrUnknownVersion, v)
+}
+
+func hashNode(v Version, hl string, hr string) (string, error) {
+	switch v {
+	case V1:
+		return generateLeafHash(hl, hr), nil
+	case V2:
+		return hashPrefixed(nodePrefix, hl, hr)
+	}
+	return "", fmt.Errorf("%w: %d", ErrUnknownVersion, v)
+}
+
+func New(v Version, hs []string) (*Tree, error) {
+	level := make([]string, 0, len(hs))
+	for _, leaf := range hs {
+		h, err := hashLeaf(v, leaf)
+		if err != nil {
+			return nil, err
+		}
+		level = append(level, h)
+	}
+	t := &Tree{version: v, leaves: append([]string(nil), hs...), levels: [][]string{level}}
+	for len(level) > 1 {
+		next := make([]string, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 < len(level) {
+				h, err := hashNode(v, level[i], level[i+1])
+				if err != nil {
+					return nil, err
+				}
+				next = append(next, h)
+				continue
+			}
+			next = append(next, level[i])
+		}
+		t.levels = append(t.levels, next)
+		level = next
+	}
+	return t, nil
+}
+
+func (t *Tree) Version() Version {
+	return t.version
+}
+
+func (t *Tree) Root() string {
+	top := t.levels[len(t.levels)-1]
+	if len(top) == 0 {
 		return ""
 	}
-	if len(hs) == 1 {
-		return hs[0]
-	}
-	nhs := make([]string, 0)
-	i := 0
-	for i < len(hs) {
-		if i+1 < len(hs) {
-			l := hs[i]
-			r := hs[i+1]
-			nhs = append(nhs, generateLeafHash(l, r))
-			i += 2
-			continue
+	return top[0]
+}
+
+func (t *Tree) Leaves() []string {
+	return t.leaves
+}
+
+func (t *Tree) Proof(index int) (*Proof, error) {
+	if index < 0 || index >= len(t.leaves) {
+		return nil, fmt.Errorf("%w: %d", ErrLeafOutOfRange, index)
+	}
+	p := &Proof{Index: index, Leaf: t.leaves[index], Path: make([]ProofStep, 0, len(t.levels)-1)}
+	for _, level := range t.levels[:len(t.levels)-1] {
+		if index%2 == 1 {
+			p.Path = append(p.Path, ProofStep{Hash: level[index-1], Left: true})
+		} else if index+1 < len(level) {
+			p.Path = append(p.Path, ProofStep{Hash: level[index+1]})
 		}
-		l := hs[i]
-		nhs = append(nhs, l)
-		i++
+		index /= 2
+	}
+	return p, nil
+}
+
+func VerifyProof(v Version, root string, p Proof) bool {
+	h, err := hashLeaf(v, p.Leaf)
+	if err != nil {
+		return false
+	}
+	for _, step := range p.Path {
+		if step.Left {
+			h, err = hashNode(v, step.Hash, h)
+		} else {
+			h, err = hashNode(v, h, step.Hash)
+		}
+		if err != nil {
+			return false
+		}
+	}
+	return h == root
+}
+
+func GenerateRootHash(v Version, hs []string) (string, error) {
+	t, err := New(v, hs)
+	if err != nil {
+		return "", err
 	}
-	return GenerateRootHash(nhs)
+	return t.Root(), nil
 }
@@ -0,0 +1,114 @@
+package merkletree
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func testLeaves(n int) []string {
+	hs := make([]string, n)
+	for i := range hs {
+		h := sha256.Sum256([]byte(fmt.Sprintf("leaf %d", i)))
+		hs[i] = hex.EncodeToString(h[:])
+	}
+	return hs
+}
+
+func TestProofVerifiesEveryLeaf(t *testing.T) {
+	for _, v := range []Version{V1, V2} {
+		for n := 1; n <= 9; n++ {
+			t.Run(fmt.Sprintf("v%d/%d leaves", v, n), func(t *testing.T) {
+				tree, err := New(v, testLeaves(n))
+				if err != nil {
+					t.Fatal(err)
+				}
+				for i := 0; i < n; i++ {
+					p, err := tree.Proof(i)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if !VerifyProof(v, tree.Root(), *p) {
+						t.Fatalf("proof for leaf %d doesn't verify", i)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestVerifyProofRejectsTamperedProofs(t *testing.T) {
+	for _, v := range []Version{V1, V2} {
+		t.Run(fmt.Sprintf("v%d", v), func(t *testing.T) {
+			leaves := testLeaves(7)
+			tree, err := New(v, leaves)
+			if err != nil {
+				t.Fatal(err)
+			}
+			p, err := tree.Proof(6)
+			if err != nil {
+				t.Fatal(err)
+			}
+			leaf := *p
+			leaf.Leaf = leaves[5]
+			if VerifyProof(v, tree.Root(), leaf) {
+				t.Fatal("proof verified with another leaf")
+			}
+			p, err = tree.Proof(2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			side := *p
+			side.Path = append([]ProofStep(nil), p.Path...)
+			side.Path[0].Left = !side.Path[0].Left
+			if VerifyProof(v, tree.Root(), side) {
+				t.Fatal("proof verified with a flipped sibling side")
+			}
+			pathless := *p
+			pathless.Path = nil
+			if VerifyProof(v, tree.Root(), pathless) {
+				t.Fatal("proof verified without its path")
+			}
+		})
+	}
+}
+
+func TestVersionsCommitToDifferentRoots(t *testing.T) {
+	leaves := testLeaves(5)
+	v1, err := GenerateRootHash(V1, leaves)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v2, err := GenerateRootHash(V2, leaves)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v1 == v2 {
+		t.Fatal("v1 and v2 trees share a root")
+	}
+	tree, err := New(V2, leaves)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := tree.Proof(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if VerifyProof(V1, v2, *p) {
+		t.Fatal("v2 proof verified as v1")
+	}
+}
+
+func TestProofRejectsOutOfRangeLeaf(t *testing.T) {
+	tree, err := New(V2, testLeaves(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range []int{-1, 3} {
+		if _, err := tree.Proof(i); !errors.Is(err, ErrLeafOutOfRange) {
+			t.Fatalf("got error %v for leaf %d, want %v", err, i, ErrLeafOutOfRange)
+		}
+	}
+}
@@ -0,0 +1,344 @@
+package merkletree
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+const SparseTreeDepth = 256
+
+var ErrInvalidSparseKey = errors.New("sparse merkle key isn't a hex encoded 32 byte hash")
+
+var emptySubtreeHashes = func() [SparseTreeDepth + 1][sha256.Size]byte {
+	var hs [SparseTreeDepth + 1][sha256.Size]byte
+	for i := 1; i <= SparseTreeDepth; i++ {
+		hs[i] = hashSparseNode(hs[i-1], hs[i-1])
+	}
+	return hs
+}()
+
+type SparseTree struct {
+	root *sparseNode
+	size int
+}
+
+type sparseNode struct {
+	key   [sha256.Size]byte
+	value [sha256.Size]byte
+	depth int
+	left  *sparseNode
+	right *sparseNode
+	lhash [sha256.Size]byte
+	rhash [sha256.Size]byte
+	hash  [sha256.Size]byte
+}
+
+type SparseProof struct {
+	Key      string   `json:"key"`
+	Value    string   `json:"value,omitempty"`
+	Bitmap   []byte   `json:"bitmap"`
+	Siblings []string `json:"siblings"`
+}
+
+func hashSparseLeaf(k, v [sha256.Size]byte) [sha256.Size]byte {
+	var buf [1 + 2*sha256.Size]byte
+	buf[0] = leafPrefix
+	copy(buf[1:], k[:])
+	copy(buf[1+sha256.Size:], v[:])
+	return sha256.Sum256(buf[:])
+}
+
+func hashSparseNode(l, r [sha256.Size]byte) [sha256.Size]byte {
+	var buf [1 + 2*sha256.Size]byte
+	buf[0] = nodePrefix
+	copy(buf[1:], l[:])
+	copy(buf[1+sha256.Size:], r[:])
+	return sha256.Sum256(buf[:])
+}
+
+func decodeSparseKey(s string) ([sha256.Size]byte, error) {
+	var k [sha256.Size]byte
+	bs, err := hex.DecodeString(s)
+	if err != nil || len(bs) != sha256.Size {
+		return k, fmt.Errorf("%w: %q", ErrInvalidSparseKey, s)
+	}
+	copy(k[:], bs)
+	return k, nil
+}
+
+func bitAt(k [sha256.Size]byte, depth int) bool {
+	return k[depth/8]&(0x80>>(depth%8)) != 0
+}
+
+func commonPrefixLen(a, b [sha256.Size]byte) int {
+	for i := range a {
+		if x := a[i] ^ b[i]; x != 0 {
+			n := 0
+			for ; x&0x80 == 0; x <<= 1 {
+				n++
+			}
+			return i*8 + n
+		}
+	}
+	return SparseTreeDepth
+}
+
+func newSparseLeaf(k, v [sha256.Size]byte) *sparseNode {
+	return &sparseNode{key: k, value: v, depth: SparseTreeDepth, hash: hashSparseLeaf(k, v)}
+}
+
+func newSparseBranch(depth int, l, r *sparseNode) *sparseNode {
+	n := &sparseNode{key: l.key, depth: depth, left: l, right: r}
+	n.lhash = l.liftTo(depth + 1)
+	n.rhash = r.liftTo(depth + 1)
+	n.hash = hashSparseNode(n.lhash, n.rhash)
+	return n
+}
+
+func (n *sparseNode) withChildren(l, r *sparseNode) *sparseNode {
+	c := &sparseNode{key: l.key, depth: n.depth, left: l, right: r, lhash: n.lhash, rhash: n.rhash}
+	if l != n.left {
+		c.lhash = l.liftTo(n.depth + 1)
+	}
+	if r != n.right {
+		c.rhash = r.liftTo(n.depth + 1)
+	}
+	c.hash = hashSparseNode(c.lhash, c.rhash)
+	return c
+}
+
+func (n *sparseNode) isLeaf() bool {
+	return n.depth == SparseTreeDepth
+}
+
+func (n *sparseNode) liftTo(depth int) [sha256.Size]byte {
+	h := n.hash
+	for d := n.depth - 1; d >= depth; d-- {
+		if bitAt(n.key, d) {
+			h = hashSparseNode(emptySubtreeHashes[SparseTreeDepth-d-1], h)
+		} else {
+			h = hashSparseNode(h, emptySubtreeHashes[SparseTreeDepth-d-1])
+		}
+	}
+	return h
+}
+
+func insertSparse(n *sparseNode, k, v [sha256.Size]byte) *sparseNode {
+	if n == nil {
+		return newSparseLeaf(k, v)
+	}
+	if d := commonPrefixLen(n.key, k); d < n.depth {
+		if bitAt(k, d) {
+			return newSparseBranch(d, n, newSparseLeaf(k, v))
+		}
+		return newSparseBranch(d, newSparseLeaf(k, v), n)
+	}
+	if n.isLeaf() {
+		return newSparseLeaf(k, v)
+	}
+	if bitAt(k, n.depth) {
+		return n.withChildren(n.left, insertSparse(n.right, k, v))
+	}
+	return n.withChildren(insertSparse(n.left, k, v), n.right)
+}
+
+func deleteSparse(n *sparseNode, k [sha256.Size]byte) *sparseNode {
+	if n == nil || commonPrefixLen(n.key, k) < n.depth {
+		return n
+	}
+	if n.isLeaf() {
+		return nil
+	}
+	l, r := n.left, n.right
+	if bitAt(k, n.depth) {
+		r = deleteSparse(r, k)
+	} else {
+		l = deleteSparse(l, k)
+	}
+	switch {
+	case l == n.left && r == n.right:
+		return n
+	case l == nil:
+		return r
+	case r == nil:
+		return l
+	}
+	return n.withChildren(l, r)
+}
+
+func (t *SparseTree) get(k [sha256.Size]byte) (*sparseNode, bool) {
+	n := t.root
+	for n != nil && commonPrefixLen(n.key, k) >= n.depth {
+		if n.isLeaf() {
+			return n, true
+		}
+		if bitAt(k, n.depth) {
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return nil, false
+}
+
+func NewSparseTree() *SparseTree {
+	return &SparseTree{}
+}
+
+func buildSparse(leaves []*sparseNode) *sparseNode {
+	if len(leaves) == 1 {
+		return leaves[0]
+	}
+	d := commonPrefixLen(leaves[0].key, leaves[len(leaves)-1].key)
+	i := sort.Search(len(leaves), func(i int) bool { return bitAt(leaves[i].key, d) })
+	return newSparseBranch(d, buildSparse(leaves[:i]), buildSparse(leaves[i:]))
+}
+
+func NewSparseTreeFromMap(kvs map[string]string) (*SparseTree, error) {
+	leaves := make([]*sparseNode, 0, len(kvs))
+	for key, value := range kvs {
+		k, err := decodeSparseKey(key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := decodeSparseKey(value)
+		if err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, newSparseLeaf(k, v))
+	}
+	sort.Slice(leaves, func(i, j int) bool { return bytes.Compare(leaves[i].key[:], leaves[j].key[:]) < 0 })
+	t := &SparseTree{size: len(leaves)}
+	if len(leaves) > 0 {
+		t.root = buildSparse(leaves)
+	}
+	return t, nil
+}
+
+func (t *SparseTree) Update(key, value string) error {
+	k, err := decodeSparseKey(key)
+	if err != nil {
+		return err
+	}
+	v, err := decodeSparseKey(value)
+	if err != nil {
+		return err
+	}
+	if _, ok := t.get(k); !ok {
+		t.size++
+	}
+	t.root = insertSparse(t.root, k, v)
+	return nil
+}
+
+func (t *SparseTree) Delete(key string) error {
+	k, err := decodeSparseKey(key)
+	if err != nil {
+		return err
+	}
+	if _, ok := t.get(k); ok {
+		t.size--
+		t.root = deleteSparse(t.root, k)
+	}
+	return nil
+}
+
+func (t *SparseTree) Get(key string) (string, bool) {
+	k, err := decodeSparseKey(key)
+	if err != nil {
+		return "", false
+	}
+	n, ok := t.get(k)
+	if !ok {
+		return "", false
+	}
+	return hex.EncodeToString(n.value[:]), true
+}
+
+func (t *SparseTree) Len() int {
+	return t.size
+}
+
+func (t *SparseTree) Clone() *SparseTree {
+	c := *t
+	return &c
+}
+
+func (t *SparseTree) Root() string {
+	root := emptySubtreeHashes[SparseTreeDepth]
+	if t.root != nil {
+		root = t.root.liftTo(0)
+	}
+	return hex.EncodeToString(root[:])
+}
+
+func (t *SparseTree) Proof(key string) (*SparseProof, error) {
+	k, err := decodeSparseKey(key)
+	if err != nil {
+		return nil, err
+	}
+	p := &SparseProof{Key: key, Bitmap: make([]byte, SparseTreeDepth/8), Siblings: make([]string, 0)}
+	addSibling := func(depth int, h [sha256.Size]byte) {
+		p.Bitmap[depth/8] |= 0x80 >> (depth % 8)
+		p.Siblings = append(p.Siblings, hex.EncodeToString(h[:]))
+	}
+	n := t.root
+	for n != nil {
+		if d := commonPrefixLen(n.key, k); d < n.depth {
+			addSibling(d, n.liftTo(d+1))
+			break
+		}
+		if n.isLeaf() {
+			p.Value = hex.EncodeToString(n.value[:])
+			break
+		}
+		if bitAt(k, n.depth) {
+			addSibling(n.depth, n.lhash)
+			n = n.right
+		} else {
+			addSibling(n.depth, n.rhash)
+			n = n.left
+		}
+	}
+	return p, nil
+}
+
+func VerifySparseProof(root string, p SparseProof) bool {
+	k, err := decodeSparseKey(p.Key)
+	if err != nil || len(p.Bitmap) != SparseTreeDepth/8 {
+		return false
+	}
+	h := emptySubtreeHashes[0]
+	if p.Value != "" {
+		v, err := decodeSparseKey(p.Value)
+		if err != nil {
+			return false
+		}
+		h = hashSparseLeaf(k, v)
+	}
+	next := len(p.Siblings)
+	for depth := SparseTreeDepth - 1; depth >= 0; depth-- {
+		sibling := emptySubtreeHashes[SparseTreeDepth-depth-1]
+		if p.Bitmap[depth/8]&(0x80>>(depth%8)) != 0 {
+			if next == 0 {
+				return false
+			}
+			next--
+			s, err := decodeSparseKey(p.Siblings[next])
+			if err != nil {
+				return false
+			}
+			sibling = s
+		}
+		if bitAt(k, depth) {
+			h = hashSparseNode(sibling, h)
+		} else {
+			h = hashSparseNode(h, sibling)
+		}
+	}
+	return next == 0 && hex.EncodeToString(h[:]) == root
+}
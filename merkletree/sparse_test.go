@@ -0,0 +1,131 @@
+package merkletree
+
+import (
+	"errors"
+	"testing"
+)
+
+func testSparseEntries(n int) map[string]string {
+	leaves := testLeaves(2 * n)
+	keys, values := leaves[:n], leaves[n:]
+	kvs := make(map[string]string, n)
+	for i, k := range keys {
+		kvs[k] = values[i]
+	}
+	return kvs
+}
+
+func newTestSparseTree(t *testing.T, kvs map[string]string) *SparseTree {
+	t.Helper()
+	tree := NewSparseTree()
+	for k, v := range kvs {
+		if err := tree.Update(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return tree
+}
+
+func TestSparseProofInclusion(t *testing.T) {
+	kvs := testSparseEntries(20)
+	tree := newTestSparseTree(t, kvs)
+	for k, v := range kvs {
+		p, err := tree.Proof(k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Value != v {
+			t.Fatalf("got value %s for key %s, want %s", p.Value, k, v)
+		}
+		if !VerifySparseProof(tree.Root(), *p) {
+			t.Fatalf("inclusion proof for key %s doesn't verify", k)
+		}
+		forged := *p
+		forged.Value = testLeaves(1)[0]
+		if VerifySparseProof(tree.Root(), forged) {
+			t.Fatalf("inclusion proof for key %s verified with another value", k)
+		}
+		absent := *p
+		absent.Value = ""
+		if VerifySparseProof(tree.Root(), absent) {
+			t.Fatalf("present key %s verified as absent", k)
+		}
+	}
+}
+
+func TestSparseProofNonInclusion(t *testing.T) {
+	kvs := testSparseEntries(20)
+	trees := map[string]*SparseTree{
+		"empty":     NewSparseTree(),
+		"populated": newTestSparseTree(t, kvs),
+	}
+	absent := testLeaves(50)[40:]
+	for name, tree := range trees {
+		t.Run(name, func(t *testing.T) {
+			for _, k := range absent {
+				p, err := tree.Proof(k)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if p.Value != "" {
+					t.Fatalf("got value %s for absent key %s", p.Value, k)
+				}
+				if !VerifySparseProof(tree.Root(), *p) {
+					t.Fatalf("non-inclusion proof for key %s doesn't verify", k)
+				}
+			}
+		})
+	}
+}
+
+func TestSparseRootAfterDeleteMatchesRebuild(t *testing.T) {
+	kvs := testSparseEntries(30)
+	tree := newTestSparseTree(t, kvs)
+	before := tree.Clone()
+	remaining := make(map[string]string, len(kvs))
+	i := 0
+	for k, v := range kvs {
+		if i%3 == 0 {
+			if err := tree.Delete(k); err != nil {
+				t.Fatal(err)
+			}
+		} else {
+			remaining[k] = v
+		}
+		i++
+	}
+	rebuilt, err := NewSparseTreeFromMap(remaining)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := tree.Root(), rebuilt.Root(); got != want {
+		t.Fatalf("got root %s after deletes, want %s", got, want)
+	}
+	if got, want := tree.Root(), newTestSparseTree(t, remaining).Root(); got != want {
+		t.Fatalf("got root %s after deletes, want %s from inserts", got, want)
+	}
+	if tree.Len() != len(remaining) {
+		t.Fatalf("got %d leaves, want %d", tree.Len(), len(remaining))
+	}
+	if before.Root() != newTestSparseTree(t, kvs).Root() {
+		t.Fatal("deleting from a tree changed its clone")
+	}
+	for k := range remaining {
+		if err := tree.Delete(k); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := tree.Root(), NewSparseTree().Root(); got != want {
+		t.Fatalf("got root %s after deleting every key, want the empty root %s", got, want)
+	}
+}
+
+func TestSparseTreeRejectsInvalidKeys(t *testing.T) {
+	tree := NewSparseTree()
+	if err := tree.Update("not hex", testLeaves(1)[0]); !errors.Is(err, ErrInvalidSparseKey) {
+		t.Fatalf("got error %v, want %v", err, ErrInvalidSparseKey)
+	}
+	if _, err := tree.Proof("abcd"); !errors.Is(err, ErrInvalidSparseKey) {
+		t.Fatalf("got error %v, want %v", err, ErrInvalidSparseKey)
+	}
+}
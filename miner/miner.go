@@ -0,0 +1,147 @@
+package miner
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/guiferpa/jackiechain/block"
+	"github.com/guiferpa/jackiechain/blockchain"
+)
+
+type Miner struct {
+	Workers  int
+	mu       sync.Mutex
+	hashrate float64
+}
+
+type solution struct {
+	hash   string
+	header block.BlockHeader
+}
+
+func New(workers int) *Miner {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	return &Miner{Workers: workers}
+}
+
+func (m *Miner) Hashrate() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.hashrate
+}
+
+func (m *Miner) Mine(ctx context.Context, t *blockchain.BlockTemplate) (string, error) {
+	b := t.Block
+	target := block.CompactToBig(b.Header.Bits)
+	if target.Sign() <= 0 {
+		return "", fmt.Errorf("bits %08x has no positive target", b.Header.Bits)
+	}
+	var hashes uint64
+	start := time.Now()
+	defer func() { m.setHashrate(hashes, time.Since(start)) }()
+	for {
+		s, n, err := m.search(ctx, b.Header, target)
+		hashes += n
+		if err != nil {
+			return "", err
+		}
+		if s != nil {
+			b.Header = s.header
+			return s.hash, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		if err := roll(t); err != nil {
+			return "", err
+		}
+	}
+}
+
+func roll(t *blockchain.BlockTemplate) error {
+	b := t.Block
+	b.Header.Nonce = 0
+	if now := max(time.Now().UnixMilli(), t.MinTimestamp); now > b.Header.Timestamp {
+		b.Header.Timestamp = now
+		return nil
+	}
+	return blockchain.IncrementExtraNonce(t)
+}
+
+func (m *Miner) search(ctx context.Context, bh block.BlockHeader, target *big.Int) (*solution, uint64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var hashes atomic.Uint64
+	found := make(chan solution, 1)
+	errs := make(chan error, m.Workers)
+	var wg sync.WaitGroup
+	for i := 0; i < m.Workers; i++ {
+		wbh := bh
+		wbh.Nonce = uint32(i)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ok, err := work(ctx, wbh, uint32(m.Workers), target, &hashes, found)
+			if err != nil {
+				errs <- err
+			}
+			if ok || err != nil {
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+
+	select {
+	case s := <-found:
+		return &s, hashes.Load(), nil
+	case err := <-errs:
+		return nil, hashes.Load(), err
+	default:
+		return nil, hashes.Load(), nil
+	}
+}
+
+func work(ctx context.Context, bh block.BlockHeader, step uint32, target *big.Int, hashes *atomic.Uint64, found chan<- solution) (bool, error) {
+	for ctx.Err() == nil {
+		h, err := block.GenerateBlockHeaderHash(bh)
+		if err != nil {
+			return false, err
+		}
+		hashes.Add(1)
+		n, ok := block.HashToBig(h)
+		if !ok {
+			return false, fmt.Errorf("invalid block hash %s", h)
+		}
+		if n.Cmp(target) <= 0 {
+			select {
+			case found <- solution{hash: h, header: bh}:
+			default:
+			}
+			return true, nil
+		}
+		if bh.Nonce > math.MaxUint32-step {
+			return false, nil
+		}
+		bh.Nonce += step
+	}
+	return false, nil
+}
+
+func (m *Miner) setHashrate(hashes uint64, elapsed time.Duration) {
+	if elapsed <= 0 {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hashrate = float64(hashes) / elapsed.Seconds()
+}
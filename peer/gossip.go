@@ -0,0 +1,325 @@
+package peer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guiferpa/jackiechain/block"
+	"github.com/guiferpa/jackiechain/blockchain"
+	"github.com/guiferpa/jackiechain/logger"
+	"github.com/guiferpa/jackiechain/merkletree"
+	protonet "github.com/guiferpa/jackiechain/proto/net"
+	"github.com/guiferpa/jackiechain/store"
+	"github.com/guiferpa/jackiechain/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	GossipTimeout  = 5 * time.Second
+	MaxOrphanDepth = 100
+)
+
+func (p *Peer) markSeen(seen map[string]struct{}, h string) bool {
+	p.seenMu.Lock()
+	defer p.seenMu.Unlock()
+	if _, ok := seen[h]; ok {
+		return false
+	}
+	seen[h] = struct{}{}
+	return true
+}
+
+func (p *Peer) unmarkSeen(seen map[string]struct{}, h string) {
+	p.seenMu.Lock()
+	defer p.seenMu.Unlock()
+	delete(seen, h)
+}
+
+func (p *Peer) relayTx(h string, from ID) {
+	for id, remote := range p.remotes() {
+		if id == from {
+			continue
+		}
+		go func(id ID, remote Remote) {
+			clients, err := p.getProtoClients(remote)
+			if err != nil {
+				logger.Red(err.Error())
+				return
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), GossipTimeout)
+			defer cancel()
+			if _, err := clients.net.AnnounceTx(ctx, &protonet.AnnounceTxRequest{Pid: string(p.ID), Hash: h}); err != nil {
+				logger.Red(fmt.Sprintf("Announce tx %s to peer %s failed: %s", h, id, err))
+			}
+		}(id, remote)
+	}
+}
+
+func (p *Peer) relayBlock(h string, from ID) {
+	for id, remote := range p.remotes() {
+		if id == from {
+			continue
+		}
+		go func(id ID, remote Remote) {
+			clients, err := p.getProtoClients(remote)
+			if err != nil {
+				logger.Red(err.Error())
+				return
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), GossipTimeout)
+			defer cancel()
+			if _, err := clients.net.AnnounceBlock(ctx, &protonet.AnnounceBlockRequest{Pid: string(p.ID), Hash: h}); err != nil {
+				logger.Red(fmt.Sprintf("Announce block %s to peer %s failed: %s", h, id, err))
+			}
+		}(id, remote)
+	}
+}
+
+func (p *Peer) AcceptTx(tx transaction.Tx) (string, error) {
+	h, err := transaction.GenerateTxHash(tx)
+	if err != nil {
+		return "", err
+	}
+	p.mu.Lock()
+	err = blockchain.AddTx(p.Blockchain, tx)
+	p.mu.Unlock()
+	if err != nil {
+		return "", err
+	}
+	p.markSeen(p.seenTxs, h)
+	p.relayTx(h, "")
+	return h, nil
+}
+
+func (p *Peer) fetchTx(from ID, h string) error {
+	remote, ok := p.getRemote(from)
+	if !ok {
+		return fmt.Errorf("unknown peer %s", from)
+	}
+	clients, err := p.getProtoClients(remote)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), GossipTimeout)
+	defer cancel()
+	resp, err := clients.net.GetTx(ctx, &protonet.GetTxRequest{Pid: string(p.ID), Hash: h})
+	if err != nil {
+		return err
+	}
+	tx, err := transaction.DecodeTx(resp.Tx)
+	if err != nil {
+		return err
+	}
+	txh, err := transaction.GenerateTxHash(tx)
+	if err != nil {
+		return err
+	}
+	if txh != h {
+		return fmt.Errorf("peer %s sent tx %s for %s", from, txh, h)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return blockchain.AddTx(p.Blockchain, tx)
+}
+
+func (p *Peer) requestBlock(clients *ProtoClients, h string) (*block.Block, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), GossipTimeout)
+	defer cancel()
+	resp, err := clients.net.GetBlock(ctx, &protonet.GetBlockRequest{Pid: string(p.ID), Hash: h})
+	if err != nil {
+		return nil, err
+	}
+	b, err := block.DecodeBlock(resp.Block)
+	if err != nil {
+		return nil, err
+	}
+	bh, err := block.GenerateBlockHash(b)
+	if err != nil {
+		return nil, err
+	}
+	if bh != h {
+		return nil, fmt.Errorf("asked for block %s, got %s", h, bh)
+	}
+	return &b, nil
+}
+
+func (p *Peer) hasBlock(h string) (bool, error) {
+	if h == blockchain.GenesisPreviousBlockHash {
+		return true, nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, err := p.Blockchain.GetBlockByHash(h)
+	if errors.Is(err, store.ErrNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (p *Peer) fetchBlock(from ID, h string) error {
+	remote, ok := p.getRemote(from)
+	if !ok {
+		return fmt.Errorf("unknown peer %s", from)
+	}
+	clients, err := p.getProtoClients(remote)
+	if err != nil {
+		return err
+	}
+	branch := make(block.BlockSlice, 0)
+	for next := h; ; {
+		if len(branch) >= MaxOrphanDepth {
+			return fmt.Errorf("block %s is more than %d blocks away from the chain", h, MaxOrphanDepth)
+		}
+		b, err := p.requestBlock(clients, next)
+		if err != nil {
+			return err
+		}
+		branch = append(branch, *b)
+		has, err := p.hasBlock(b.Header.PreviousBlockHash)
+		if err != nil {
+			return err
+		}
+		if has {
+			break
+		}
+		next = b.Header.PreviousBlockHash
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i := len(branch) - 1; i >= 0; i-- {
+		_, err := blockchain.AddBlock(p.Blockchain, branch[i])
+		if err != nil && !errors.Is(err, blockchain.ErrBlockAlreadyKnown) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Peer) AnnounceTx(ctx context.Context, req *protonet.AnnounceTxRequest) (*protonet.AnnounceTxResponse, error) {
+	if p.markSeen(p.seenTxs, req.Hash) {
+		go func() {
+			if err := p.fetchTx(ID(req.Pid), req.Hash); err != nil {
+				p.unmarkSeen(p.seenTxs, req.Hash)
+				logger.Red(fmt.Sprintf("Tx %s from peer %s rejected: %s", req.Hash, req.Pid, err))
+				return
+			}
+			logger.Yellow(fmt.Sprintf("Tx %s received from peer %s", req.Hash, req.Pid))
+			p.relayTx(req.Hash, ID(req.Pid))
+		}()
+	}
+	return &protonet.AnnounceTxResponse{Pid: string(p.ID), Status: uint32(0)}, nil
+}
+
+func (p *Peer) AnnounceBlock(ctx context.Context, req *protonet.AnnounceBlockRequest) (*protonet.AnnounceBlockResponse, error) {
+	if p.markSeen(p.seenBlocks, req.Hash) {
+		go func() {
+			if err := p.fetchBlock(ID(req.Pid), req.Hash); err != nil {
+				p.unmarkSeen(p.seenBlocks, req.Hash)
+				logger.Red(fmt.Sprintf("Block %s from peer %s rejected: %s", req.Hash, req.Pid, err))
+				return
+			}
+			logger.Yellow(fmt.Sprintf("Block %s received from peer %s", req.Hash, req.Pid))
+			p.relayBlock(req.Hash, ID(req.Pid))
+		}()
+	}
+	return &protonet.AnnounceBlockResponse{Pid: string(p.ID), Status: uint32(0)}, nil
+}
+
+func (p *Peer) GetTx(ctx context.Context, req *protonet.GetTxRequest) (*protonet.GetTxResponse, error) {
+	p.mu.Lock()
+	tx, ok := p.Blockchain.PendingTxs[req.Hash]
+	if !ok {
+		chaintx, err := blockchain.GetTx(p.Blockchain, req.Hash)
+		if err == nil {
+			tx, ok = *chaintx, true
+		} else if !errors.Is(err, store.ErrNotFound) {
+			p.mu.Unlock()
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	p.mu.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "tx %s not found", req.Hash)
+	}
+	bs, err := tx.Bytes()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &protonet.GetTxResponse{Pid: string(p.ID), Tx: bs}, nil
+}
+
+func (p *Peer) SubmitTx(ctx context.Context, req *protonet.SubmitTxRequest) (*protonet.SubmitTxResponse, error) {
+	tx, err := transaction.DecodeTx(req.Tx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	h, err := p.AcceptTx(tx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	logger.Yellow(fmt.Sprintf("Tx %s submitted by %s", h, req.Pid))
+	return &protonet.SubmitTxResponse{Pid: string(p.ID), Hash: h}, nil
+}
+
+func (p *Peer) GetBlock(ctx context.Context, req *protonet.GetBlockRequest) (*protonet.GetBlockResponse, error) {
+	p.mu.Lock()
+	b, err := p.Blockchain.GetBlockByHash(req.Hash)
+	p.mu.Unlock()
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "block %s not found", req.Hash)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	bs, err := b.Bytes()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &protonet.GetBlockResponse{Pid: string(p.ID), Block: bs}, nil
+}
+
+func (p *Peer) GetTxProof(ctx context.Context, req *protonet.GetTxProofRequest) (*protonet.GetTxProofResponse, error) {
+	p.mu.Lock()
+	bh, proof, err := blockchain.GetTxProof(p.Blockchain, req.Hash)
+	p.mu.Unlock()
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "tx %s not found on chain", req.Hash)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &protonet.GetTxProofResponse{
+		Pid:       string(p.ID),
+		BlockHash: bh,
+		Index:     int64(proof.Index),
+		Leaf:      proof.Leaf,
+		Path:      make([]*protonet.ProofStep, 0, len(proof.Path)),
+	}
+	for _, step := range proof.Path {
+		resp.Path = append(resp.Path, &protonet.ProofStep{Hash: step.Hash, Left: step.Left})
+	}
+	return resp, nil
+}
+
+func (p *Peer) GetUTxOProof(ctx context.Context, req *protonet.GetUTxOProofRequest) (*protonet.GetUTxOProofResponse, error) {
+	p.mu.Lock()
+	bh, proof, err := blockchain.GetUTxOProof(p.Blockchain, req.Hash)
+	p.mu.Unlock()
+	if errors.Is(err, merkletree.ErrInvalidSparseKey) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &protonet.GetUTxOProofResponse{
+		Pid:       string(p.ID),
+		BlockHash: bh,
+		Key:       proof.Key,
+		Value:     proof.Value,
+		Bitmap:    proof.Bitmap,
+		Siblings:  proof.Siblings,
+	}, nil
+}
@@ -2,6 +2,7 @@ package peer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"sync"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/guiferpa/jackiechain/blockchain"
 	"github.com/guiferpa/jackiechain/logger"
+	"github.com/guiferpa/jackiechain/miner"
 	protogreeter "github.com/guiferpa/jackiechain/proto/greeter"
 	protonet "github.com/guiferpa/jackiechain/proto/net"
 	"google.golang.org/grpc"
@@ -39,36 +41,113 @@ type Peer struct {
 	ID            ID
 	IP            []byte
 	Port          int
+	ServerPort    int
 	PeerRemoteMap map[ID]Remote
 	Blockchain    *blockchain.Blockchain
+	MinerAddress  string
+	Miner         *miner.Miner
+	mu            sync.Mutex
+	miningMu      sync.Mutex
+	cancelMining  context.CancelFunc
+	remotesMu     sync.RWMutex
+	clients       map[Remote]*ProtoClients
+	seenMu        sync.Mutex
+	seenTxs       map[string]struct{}
+	seenBlocks    map[string]struct{}
 	protogreeter.UnimplementedGreeterServer
 	protonet.UnimplementedNetServer
 }
 
+func (p *Peer) getRemote(id ID) (Remote, bool) {
+	p.remotesMu.RLock()
+	defer p.remotesMu.RUnlock()
+	remote, ok := p.PeerRemoteMap[id]
+	return remote, ok
+}
+
+func (p *Peer) setRemote(id ID, remote Remote) {
+	p.remotesMu.Lock()
+	defer p.remotesMu.Unlock()
+	p.PeerRemoteMap[id] = remote
+}
+
+func (p *Peer) remotes() map[ID]Remote {
+	p.remotesMu.RLock()
+	defer p.remotesMu.RUnlock()
+	remotes := make(map[ID]Remote, len(p.PeerRemoteMap))
+	for id, remote := range p.PeerRemoteMap {
+		remotes[id] = remote
+	}
+	return remotes
+}
+
+func (p *Peer) getProtoClients(remote Remote) (*ProtoClients, error) {
+	p.remotesMu.Lock()
+	defer p.remotesMu.Unlock()
+	if clients, ok := p.clients[remote]; ok {
+		return clients, nil
+	}
+	clients, err := GetProtoClients(remote)
+	if err != nil {
+		return nil, err
+	}
+	p.clients[remote] = clients
+	return clients, nil
+}
+
 func (p *Peer) ReachOut(ctx context.Context, pr *protogreeter.PingRequest) (*protogreeter.PongResponse, error) {
 	logger.Yellow(fmt.Sprintf("Ping from agent %s", pr.Aid))
 	return &protogreeter.PongResponse{Pid: string(p.ID)}, nil
 }
 
+func (p *Peer) checkConsensusParams(params string) error {
+	local, err := blockchain.GenerateConsensusParamsHash(p.Blockchain)
+	if err != nil {
+		return err
+	}
+	if params != local {
+		return fmt.Errorf("%w: local %s, remote %s", blockchain.ErrConsensusParamsMismatch, local, params)
+	}
+	return nil
+}
+
 func (p *Peer) Connect(ctx context.Context, cr *protonet.ConnectRequest) (*protonet.ConnectResponse, error) {
 	pctx, ok := peer.FromContext(ctx)
 	if !ok {
 		return nil, nil
 	}
 	logger.Yellow(fmt.Sprintf("Connection request from peer %s", cr.Pid))
-	if len(p.PeerRemoteMap) > 0 {
+	if err := p.checkConsensusParams(cr.Params); err != nil {
+		logger.Red(fmt.Sprintf("Refused peer %s: %s", cr.Pid, err))
+		return nil, err
+	}
+	host, _, err := net.SplitHostPort(pctx.Addr.String())
+	if err != nil {
+		logger.Red(err.Error())
+		return nil, nil
+	}
+	_, port, err := net.SplitHostPort(pctx.LocalAddr.String())
+	if err != nil {
+		logger.Red(err.Error())
+		return nil, nil
+	}
+	if _, rport, err := net.SplitHostPort(cr.Remote); err == nil && rport != "" {
+		port = rport
+	}
+	remote := Remote(fmt.Sprintf("%v:%v", host, port))
+	if premotes := p.remotes(); len(premotes) > 0 {
 		var wg sync.WaitGroup
-		for id, premote := range p.PeerRemoteMap {
+		for id, premote := range premotes {
 			wg.Add(1)
-			go func(id string, remote Remote) {
+			go func(id string, premote Remote) {
 				defer wg.Done()
-				clients, err := GetProtoClients(remote)
+				clients, err := p.getProtoClients(premote)
 				if err != nil {
 					logger.Red(err.Error())
 					return
 				}
 				logger.Yellow(fmt.Sprintf("Send connection to peer %s", id))
-				scr := &protonet.SendConnectionRequest{Pid: cr.Pid, Remote: cr.Remote}
+				scr := &protonet.SendConnectionRequest{Pid: cr.Pid, Remote: string(remote)}
 				_, err = clients.net.SendConnection(ctx, scr)
 				if err != nil {
 					logger.Red(err.Error())
@@ -78,54 +157,100 @@ func (p *Peer) Connect(ctx context.Context, cr *protonet.ConnectRequest) (*proto
 		}
 		wg.Wait()
 	}
-	host, _, err := net.SplitHostPort(pctx.Addr.String())
-	if err != nil {
-		logger.Red(err.Error())
-		return nil, nil
-	}
-	_, port, err := net.SplitHostPort(pctx.LocalAddr.String())
+	p.setRemote(ID(cr.Pid), remote)
+	params, err := blockchain.GenerateConsensusParamsHash(p.Blockchain)
 	if err != nil {
-		logger.Red(err.Error())
-		return nil, nil
+		return nil, err
 	}
-	p.PeerRemoteMap[ID(cr.Pid)] = Remote(fmt.Sprintf("%v:%v", host, port))
-	return &protonet.ConnectResponse{Pid: string(p.ID), Status: uint32(0)}, nil
+	return &protonet.ConnectResponse{Pid: string(p.ID), Status: uint32(0), Params: params}, nil
 }
 
 func (p *Peer) SendConnection(ctx context.Context, scr *protonet.SendConnectionRequest) (*protonet.SendConnectionResponse, error) {
 	logger.Yellow(fmt.Sprintf("Received connection about peer %s", scr.Pid))
-	p.PeerRemoteMap[ID(scr.Pid)] = Remote(scr.Remote)
-	return nil, nil
+	p.setRemote(ID(scr.Pid), Remote(scr.Remote))
+	return &protonet.SendConnectionResponse{Pid: string(p.ID), Status: uint32(0)}, nil
+}
+
+func (p *Peer) startMining() context.Context {
+	p.miningMu.Lock()
+	defer p.miningMu.Unlock()
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancelMining = cancel
+	return ctx
+}
+
+func (p *Peer) stopMining() {
+	p.miningMu.Lock()
+	defer p.miningMu.Unlock()
+	if p.cancelMining != nil {
+		p.cancelMining()
+		p.cancelMining = nil
+	}
+}
+
+func (p *Peer) buildBlock() (string, error) {
+	ctx := p.startMining()
+	defer p.stopMining()
+	p.mu.Lock()
+	t, err := blockchain.NewBlockTemplate(p.Blockchain, p.MinerAddress)
+	p.mu.Unlock()
+	if err != nil {
+		return "", err
+	}
+	if _, err := p.Miner.Mine(ctx, t); err != nil {
+		return "", err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return blockchain.AddBlock(p.Blockchain, *t.Block)
 }
 
 func (p *Peer) SetBuildBlockInterval(ticker *time.Ticker) {
 	for {
 		select {
 		case <-ticker.C:
-			bh, err := blockchain.BuildBlock(p.Blockchain)
+			bh, err := p.buildBlock()
+			if errors.Is(err, context.Canceled) {
+				logger.Yellow("Mining was interrupted by a new chain tip")
+				continue
+			}
 			if err != nil {
 				logger.Red(err.Error())
 				continue
 			}
-			logger.Magenta(fmt.Sprintf("Block %s was built", bh))
+			logger.Magenta(fmt.Sprintf("Block %s was built (%.0f H/s)", bh, p.Miner.Hashrate()))
+			p.markSeen(p.seenBlocks, bh)
+			p.relayBlock(bh, "")
 		}
 	}
 }
 
-func (p *Peer) TryConnect(conn grpc.ClientConnInterface) error {
-	netclient := protonet.NewNetClient(conn)
+func (p *Peer) TryConnect(remote Remote) error {
+	clients, err := p.getProtoClients(remote)
+	if err != nil {
+		return err
+	}
 	logger.Yellow(fmt.Sprintf("Try connect IP(%v), Port(%v) to peer in network", p.IP, p.Port))
+	params, err := blockchain.GenerateConsensusParamsHash(p.Blockchain)
+	if err != nil {
+		return err
+	}
 	cr := &protonet.ConnectRequest{
 		Pid:    string(p.ID),
-		Remote: "",
+		Remote: fmt.Sprintf(":%v", p.ServerPort),
+		Params: params,
 	}
-	resp, err := netclient.Connect(context.Background(), cr)
+	resp, err := clients.net.Connect(context.Background(), cr)
 	if err != nil {
 		return err
 	}
 	if resp.Status != 0 {
 		return fmt.Errorf("TryConnect method failured with status equals %v", resp.Status)
 	}
+	if err := p.checkConsensusParams(resp.Params); err != nil {
+		return err
+	}
+	p.setRemote(ID(resp.Pid), remote)
 	logger.Yellow(fmt.Sprintf("Connection successful with peer %s", resp.Pid))
 	return nil
 }
@@ -150,5 +275,19 @@ func (p *Peer) Serve(listener net.Listener, nodeRemote string, serving chan stru
 }
 
 func New(id ID, bc *blockchain.Blockchain) *Peer {
-	return &Peer{ID: id, Blockchain: bc, PeerRemoteMap: make(map[ID]Remote, 0)}
+	p := &Peer{
+		ID:            id,
+		Blockchain:    bc,
+		Miner:         miner.New(0),
+		PeerRemoteMap: make(map[ID]Remote, 0),
+		clients:       make(map[Remote]*ProtoClients),
+		seenTxs:       make(map[string]struct{}),
+		seenBlocks:    make(map[string]struct{}),
+	}
+	bc.Subscribe(func(ev blockchain.Event) {
+		if ev.Type == blockchain.BlockConnected {
+			p.stopMining()
+		}
+	})
+	return p
 }
@@ -0,0 +1,259 @@
+package peer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/guiferpa/jackiechain/block"
+	"github.com/guiferpa/jackiechain/blockchain"
+	"github.com/guiferpa/jackiechain/logger"
+	protonet "github.com/guiferpa/jackiechain/proto/net"
+	"github.com/guiferpa/jackiechain/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	HeadersBatchSize = 500
+	BlocksBatchSize  = 16
+)
+
+func getTipWork(bc *blockchain.Blockchain) (string, *big.Int, error) {
+	h := blockchain.GenesisPreviousBlockHash
+	if bc.LatestBlock != nil {
+		var err error
+		if h, err = block.GenerateBlockHash(*bc.LatestBlock); err != nil {
+			return "", nil, err
+		}
+	}
+	work, err := blockchain.GetChainWork(bc, h)
+	if err != nil {
+		return "", nil, err
+	}
+	return h, work, nil
+}
+
+func (p *Peer) GetTip(ctx context.Context, req *protonet.GetTipRequest) (*protonet.GetTipResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	h, work, err := getTipWork(p.Blockchain)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &protonet.GetTipResponse{Pid: string(p.ID), Height: p.Blockchain.Height(), ChainWork: work.Bytes()}
+	if p.Blockchain.LatestBlock != nil {
+		resp.Hash = h
+	}
+	return resp, nil
+}
+
+func (p *Peer) GetHeaders(ctx context.Context, req *protonet.GetHeadersRequest) (*protonet.GetHeadersResponse, error) {
+	count := int(req.Count)
+	if count > HeadersBatchSize {
+		count = HeadersBatchSize
+	}
+	p.mu.Lock()
+	bhs, err := p.Blockchain.GetHeaders(req.FromHeight, count)
+	p.mu.Unlock()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &protonet.GetHeadersResponse{Pid: string(p.ID), Headers: make([][]byte, 0, len(bhs))}
+	for _, bh := range bhs {
+		bs, err := bh.Bytes()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Headers = append(resp.Headers, bs)
+	}
+	return resp, nil
+}
+
+func (p *Peer) GetBlocks(ctx context.Context, req *protonet.GetBlocksRequest) (*protonet.GetBlocksResponse, error) {
+	if len(req.Hashes) > BlocksBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d blocks per request", BlocksBatchSize)
+	}
+	resp := &protonet.GetBlocksResponse{Pid: string(p.ID), Blocks: make([][]byte, 0, len(req.Hashes))}
+	for _, h := range req.Hashes {
+		p.mu.Lock()
+		b, err := p.Blockchain.GetBlockByHash(h)
+		p.mu.Unlock()
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "block %s not found", h)
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		bs, err := b.Bytes()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Blocks = append(resp.Blocks, bs)
+	}
+	return resp, nil
+}
+
+func (p *Peer) syncHeaders(clients *ProtoClients, from int64, prev string, to int64) ([]string, error) {
+	hs := make([]string, 0)
+	for height := from; height <= to; {
+		ctx, cancel := context.WithTimeout(context.Background(), GossipTimeout)
+		resp, err := clients.net.GetHeaders(ctx, &protonet.GetHeadersRequest{Pid: string(p.ID), FromHeight: height, Count: HeadersBatchSize})
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Headers) == 0 {
+			break
+		}
+		for _, raw := range resp.Headers {
+			bh, err := block.DecodeBlockHeader(raw)
+			if err != nil {
+				return nil, err
+			}
+			if bh.Height != height || bh.PreviousBlockHash != prev {
+				return nil, fmt.Errorf("header at height %d doesn't link to %s", height, prev)
+			}
+			if err := blockchain.ValidateHeaderProofOfWork(p.Blockchain, bh); err != nil {
+				return nil, err
+			}
+			h, err := block.GenerateBlockHeaderHash(bh)
+			if err != nil {
+				return nil, err
+			}
+			hs = append(hs, h)
+			prev = h
+			height++
+		}
+	}
+	return hs, nil
+}
+
+func (p *Peer) syncBlocks(clients *ProtoClients, hs []string) error {
+	for i := 0; i < len(hs); i += BlocksBatchSize {
+		batch := hs[i:min(i+BlocksBatchSize, len(hs))]
+		ctx, cancel := context.WithTimeout(context.Background(), GossipTimeout)
+		resp, err := clients.net.GetBlocks(ctx, &protonet.GetBlocksRequest{Pid: string(p.ID), Hashes: batch})
+		cancel()
+		if err != nil {
+			return err
+		}
+		if len(resp.Blocks) != len(batch) {
+			return fmt.Errorf("asked for %d blocks, got %d", len(batch), len(resp.Blocks))
+		}
+		for j, raw := range resp.Blocks {
+			b, err := block.DecodeBlock(raw)
+			if err != nil {
+				return err
+			}
+			h, err := block.GenerateBlockHash(b)
+			if err != nil {
+				return err
+			}
+			if h != batch[j] {
+				return fmt.Errorf("asked for block %s, got %s", batch[j], h)
+			}
+			p.mu.Lock()
+			_, err = blockchain.AddBlock(p.Blockchain, b)
+			p.mu.Unlock()
+			if err != nil && !errors.Is(err, blockchain.ErrBlockAlreadyKnown) {
+				return err
+			}
+			p.markSeen(p.seenBlocks, h)
+		}
+		logger.Yellow(fmt.Sprintf("Synced %d of %d blocks", i+len(batch), len(hs)))
+	}
+	return nil
+}
+
+func (p *Peer) findForkPoint(clients *ProtoClients) (int64, string, error) {
+	p.mu.Lock()
+	height := p.Blockchain.Height()
+	p.mu.Unlock()
+	for step := int64(1); height >= 0; height -= step {
+		ctx, cancel := context.WithTimeout(context.Background(), GossipTimeout)
+		resp, err := clients.net.GetHeaders(ctx, &protonet.GetHeadersRequest{Pid: string(p.ID), FromHeight: height, Count: 1})
+		cancel()
+		if err != nil {
+			return 0, "", err
+		}
+		p.mu.Lock()
+		local, err := p.Blockchain.GetBlockByHeight(height)
+		p.mu.Unlock()
+		if err != nil {
+			return 0, "", err
+		}
+		if len(resp.Headers) == 1 {
+			bh, err := block.DecodeBlockHeader(resp.Headers[0])
+			if err != nil {
+				return 0, "", err
+			}
+			rh, err := block.GenerateBlockHeaderHash(bh)
+			if err != nil {
+				return 0, "", err
+			}
+			lh, err := block.GenerateBlockHash(*local)
+			if err != nil {
+				return 0, "", err
+			}
+			if rh == lh {
+				return height, lh, nil
+			}
+		}
+		if step < 1<<20 {
+			step *= 2
+		}
+	}
+	return -1, blockchain.GenesisPreviousBlockHash, nil
+}
+
+func (p *Peer) Sync(remote Remote) error {
+	clients, err := p.getProtoClients(remote)
+	if err != nil {
+		return err
+	}
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), GossipTimeout)
+		tip, err := clients.net.GetTip(ctx, &protonet.GetTipRequest{Pid: string(p.ID)})
+		cancel()
+		if err != nil {
+			return err
+		}
+		p.mu.Lock()
+		height := p.Blockchain.Height()
+		local, work, err := getTipWork(p.Blockchain)
+		p.mu.Unlock()
+		if err != nil {
+			return err
+		}
+		if new(big.Int).SetBytes(tip.ChainWork).Cmp(work) <= 0 {
+			logger.Yellow(fmt.Sprintf("Chain synced with peer %s at height %v", tip.Pid, height))
+			return nil
+		}
+		fork, prev, err := p.findForkPoint(clients)
+		if err != nil {
+			return err
+		}
+		logger.Yellow(fmt.Sprintf("Syncing chain from height %v to %v with peer %s", fork+1, tip.Height, tip.Pid))
+		hs, err := p.syncHeaders(clients, fork+1, prev, tip.Height)
+		if err != nil {
+			return err
+		}
+		if len(hs) == 0 {
+			return fmt.Errorf("peer %s announced height %v but sent no headers", tip.Pid, tip.Height)
+		}
+		if err := p.syncBlocks(clients, hs); err != nil {
+			return err
+		}
+		p.mu.Lock()
+		synced, _, err := getTipWork(p.Blockchain)
+		p.mu.Unlock()
+		if err != nil {
+			return err
+		}
+		if synced == local {
+			return fmt.Errorf("peer %s announced more chain work but its blocks didn't move the tip %s", tip.Pid, local)
+		}
+	}
+}
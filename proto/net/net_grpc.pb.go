@@ -21,6 +21,16 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	Net_Connect_FullMethodName        = "/net.Net/Connect"
 	Net_SendConnection_FullMethodName = "/net.Net/SendConnection"
+	Net_AnnounceTx_FullMethodName     = "/net.Net/AnnounceTx"
+	Net_AnnounceBlock_FullMethodName  = "/net.Net/AnnounceBlock"
+	Net_GetTx_FullMethodName          = "/net.Net/GetTx"
+	Net_SubmitTx_FullMethodName       = "/net.Net/SubmitTx"
+	Net_GetBlock_FullMethodName       = "/net.Net/GetBlock"
+	Net_GetTip_FullMethodName         = "/net.Net/GetTip"
+	Net_GetHeaders_FullMethodName     = "/net.Net/GetHeaders"
+	Net_GetBlocks_FullMethodName      = "/net.Net/GetBlocks"
+	Net_GetTxProof_FullMethodName     = "/net.Net/GetTxProof"
+	Net_GetUTxOProof_FullMethodName   = "/net.Net/GetUTxOProof"
 )
 
 // NetClient is the client API for Net service.
@@ -29,6 +39,16 @@ const (
 type NetClient interface {
 	Connect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*ConnectResponse, error)
 	SendConnection(ctx context.Context, in *SendConnectionRequest, opts ...grpc.CallOption) (*SendConnectionResponse, error)
+	AnnounceTx(ctx context.Context, in *AnnounceTxRequest, opts ...grpc.CallOption) (*AnnounceTxResponse, error)
+	AnnounceBlock(ctx context.Context, in *AnnounceBlockRequest, opts ...grpc.CallOption) (*AnnounceBlockResponse, error)
+	GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error)
+	SubmitTx(ctx context.Context, in *SubmitTxRequest, opts ...grpc.CallOption) (*SubmitTxResponse, error)
+	GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error)
+	GetTip(ctx context.Context, in *GetTipRequest, opts ...grpc.CallOption) (*GetTipResponse, error)
+	GetHeaders(ctx context.Context, in *GetHeadersRequest, opts ...grpc.CallOption) (*GetHeadersResponse, error)
+	GetBlocks(ctx context.Context, in *GetBlocksRequest, opts ...grpc.CallOption) (*GetBlocksResponse, error)
+	GetTxProof(ctx context.Context, in *GetTxProofRequest, opts ...grpc.CallOption) (*GetTxProofResponse, error)
+	GetUTxOProof(ctx context.Context, in *GetUTxOProofRequest, opts ...grpc.CallOption) (*GetUTxOProofResponse, error)
 }
 
 type netClient struct {
@@ -59,12 +79,122 @@ func (c *netClient) SendConnection(ctx context.Context, in *SendConnectionReques
 	return out, nil
 }
 
+func (c *netClient) AnnounceTx(ctx context.Context, in *AnnounceTxRequest, opts ...grpc.CallOption) (*AnnounceTxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnnounceTxResponse)
+	err := c.cc.Invoke(ctx, Net_AnnounceTx_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netClient) AnnounceBlock(ctx context.Context, in *AnnounceBlockRequest, opts ...grpc.CallOption) (*AnnounceBlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnnounceBlockResponse)
+	err := c.cc.Invoke(ctx, Net_AnnounceBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netClient) GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTxResponse)
+	err := c.cc.Invoke(ctx, Net_GetTx_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netClient) SubmitTx(ctx context.Context, in *SubmitTxRequest, opts ...grpc.CallOption) (*SubmitTxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitTxResponse)
+	err := c.cc.Invoke(ctx, Net_SubmitTx_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netClient) GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlockResponse)
+	err := c.cc.Invoke(ctx, Net_GetBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netClient) GetTip(ctx context.Context, in *GetTipRequest, opts ...grpc.CallOption) (*GetTipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTipResponse)
+	err := c.cc.Invoke(ctx, Net_GetTip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netClient) GetHeaders(ctx context.Context, in *GetHeadersRequest, opts ...grpc.CallOption) (*GetHeadersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHeadersResponse)
+	err := c.cc.Invoke(ctx, Net_GetHeaders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netClient) GetBlocks(ctx context.Context, in *GetBlocksRequest, opts ...grpc.CallOption) (*GetBlocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlocksResponse)
+	err := c.cc.Invoke(ctx, Net_GetBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netClient) GetTxProof(ctx context.Context, in *GetTxProofRequest, opts ...grpc.CallOption) (*GetTxProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTxProofResponse)
+	err := c.cc.Invoke(ctx, Net_GetTxProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netClient) GetUTxOProof(ctx context.Context, in *GetUTxOProofRequest, opts ...grpc.CallOption) (*GetUTxOProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUTxOProofResponse)
+	err := c.cc.Invoke(ctx, Net_GetUTxOProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NetServer is the server API for Net service.
 // All implementations must embed UnimplementedNetServer
 // for forward compatibility.
 type NetServer interface {
 	Connect(context.Context, *ConnectRequest) (*ConnectResponse, error)
 	SendConnection(context.Context, *SendConnectionRequest) (*SendConnectionResponse, error)
+	AnnounceTx(context.Context, *AnnounceTxRequest) (*AnnounceTxResponse, error)
+	AnnounceBlock(context.Context, *AnnounceBlockRequest) (*AnnounceBlockResponse, error)
+	GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error)
+	SubmitTx(context.Context, *SubmitTxRequest) (*SubmitTxResponse, error)
+	GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error)
+	GetTip(context.Context, *GetTipRequest) (*GetTipResponse, error)
+	GetHeaders(context.Context, *GetHeadersRequest) (*GetHeadersResponse, error)
+	GetBlocks(context.Context, *GetBlocksRequest) (*GetBlocksResponse, error)
+	GetTxProof(context.Context, *GetTxProofRequest) (*GetTxProofResponse, error)
+	GetUTxOProof(context.Context, *GetUTxOProofRequest) (*GetUTxOProofResponse, error)
 	mustEmbedUnimplementedNetServer()
 }
 
@@ -81,6 +211,36 @@ func (UnimplementedNetServer) Connect(context.Context, *ConnectRequest) (*Connec
 func (UnimplementedNetServer) SendConnection(context.Context, *SendConnectionRequest) (*SendConnectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendConnection not implemented")
 }
+func (UnimplementedNetServer) AnnounceTx(context.Context, *AnnounceTxRequest) (*AnnounceTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnounceTx not implemented")
+}
+func (UnimplementedNetServer) AnnounceBlock(context.Context, *AnnounceBlockRequest) (*AnnounceBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnounceBlock not implemented")
+}
+func (UnimplementedNetServer) GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTx not implemented")
+}
+func (UnimplementedNetServer) SubmitTx(context.Context, *SubmitTxRequest) (*SubmitTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTx not implemented")
+}
+func (UnimplementedNetServer) GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (UnimplementedNetServer) GetTip(context.Context, *GetTipRequest) (*GetTipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTip not implemented")
+}
+func (UnimplementedNetServer) GetHeaders(context.Context, *GetHeadersRequest) (*GetHeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeaders not implemented")
+}
+func (UnimplementedNetServer) GetBlocks(context.Context, *GetBlocksRequest) (*GetBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlocks not implemented")
+}
+func (UnimplementedNetServer) GetTxProof(context.Context, *GetTxProofRequest) (*GetTxProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxProof not implemented")
+}
+func (UnimplementedNetServer) GetUTxOProof(context.Context, *GetUTxOProofRequest) (*GetUTxOProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUTxOProof not implemented")
+}
 func (UnimplementedNetServer) mustEmbedUnimplementedNetServer() {}
 func (UnimplementedNetServer) testEmbeddedByValue()             {}
 
@@ -138,6 +298,186 @@ func _Net_SendConnection_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Net_AnnounceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnounceTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetServer).AnnounceTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Net_AnnounceTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetServer).AnnounceTx(ctx, req.(*AnnounceTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Net_AnnounceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnounceBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetServer).AnnounceBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Net_AnnounceBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetServer).AnnounceBlock(ctx, req.(*AnnounceBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Net_GetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetServer).GetTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Net_GetTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetServer).GetTx(ctx, req.(*GetTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Net_SubmitTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetServer).SubmitTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Net_SubmitTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetServer).SubmitTx(ctx, req.(*SubmitTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Net_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Net_GetBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetServer).GetBlock(ctx, req.(*GetBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Net_GetTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetServer).GetTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Net_GetTip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetServer).GetTip(ctx, req.(*GetTipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Net_GetHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetServer).GetHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Net_GetHeaders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetServer).GetHeaders(ctx, req.(*GetHeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Net_GetBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetServer).GetBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Net_GetBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetServer).GetBlocks(ctx, req.(*GetBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Net_GetTxProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetServer).GetTxProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Net_GetTxProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetServer).GetTxProof(ctx, req.(*GetTxProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Net_GetUTxOProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUTxOProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetServer).GetUTxOProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Net_GetUTxOProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetServer).GetUTxOProof(ctx, req.(*GetUTxOProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Net_ServiceDesc is the grpc.ServiceDesc for Net service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +493,46 @@ var Net_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendConnection",
 			Handler:    _Net_SendConnection_Handler,
 		},
+		{
+			MethodName: "AnnounceTx",
+			Handler:    _Net_AnnounceTx_Handler,
+		},
+		{
+			MethodName: "AnnounceBlock",
+			Handler:    _Net_AnnounceBlock_Handler,
+		},
+		{
+			MethodName: "GetTx",
+			Handler:    _Net_GetTx_Handler,
+		},
+		{
+			MethodName: "SubmitTx",
+			Handler:    _Net_SubmitTx_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _Net_GetBlock_Handler,
+		},
+		{
+			MethodName: "GetTip",
+			Handler:    _Net_GetTip_Handler,
+		},
+		{
+			MethodName: "GetHeaders",
+			Handler:    _Net_GetHeaders_Handler,
+		},
+		{
+			MethodName: "GetBlocks",
+			Handler:    _Net_GetBlocks_Handler,
+		},
+		{
+			MethodName: "GetTxProof",
+			Handler:    _Net_GetTxProof_Handler,
+		},
+		{
+			MethodName: "GetUTxOProof",
+			Handler:    _Net_GetUTxOProof_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/net/net.proto",
@@ -0,0 +1,214 @@
+package store
+
+import (
+	"encoding/binary"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/guiferpa/jackiechain/block"
+	"github.com/guiferpa/jackiechain/transaction"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	blocksBucket  = []byte("blocks")
+	worksBucket   = []byte("works")
+	undosBucket   = []byte("undos")
+	heightsBucket = []byte("heights")
+	txsBucket     = []byte("txs")
+	utxosBucket   = []byte("utxos")
+	metaBucket    = []byte("meta")
+	tipKey        = []byte("tip")
+)
+
+type Bolt struct {
+	db *bolt.DB
+}
+
+type boltBatch struct {
+	tx *bolt.Tx
+}
+
+func heightKey(height int64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, uint64(height))
+	return k
+}
+
+func (b boltBatch) PutBlock(h string, blk block.Block) error {
+	bs, err := blk.Bytes()
+	if err != nil {
+		return err
+	}
+	return b.tx.Bucket(blocksBucket).Put([]byte(h), bs)
+}
+
+func (b boltBatch) PutChainWork(h string, work *big.Int) error {
+	return b.tx.Bucket(worksBucket).Put([]byte(h), work.Bytes())
+}
+
+func (b boltBatch) PutUndo(h string, spent transaction.UTxOSlice) error {
+	bs, err := spent.Bytes()
+	if err != nil {
+		return err
+	}
+	return b.tx.Bucket(undosBucket).Put([]byte(h), bs)
+}
+
+func (b boltBatch) PutBlockHashByHeight(height int64, h string) error {
+	return b.tx.Bucket(heightsBucket).Put(heightKey(height), []byte(h))
+}
+
+func (b boltBatch) DeleteBlockHashByHeight(height int64) error {
+	return b.tx.Bucket(heightsBucket).Delete(heightKey(height))
+}
+
+func (b boltBatch) PutTxBlockHash(txh string, bh string) error {
+	return b.tx.Bucket(txsBucket).Put([]byte(txh), []byte(bh))
+}
+
+func (b boltBatch) DeleteTxBlockHash(txh string) error {
+	return b.tx.Bucket(txsBucket).Delete([]byte(txh))
+}
+
+func (b boltBatch) PutUTxO(h string, utxo transaction.UTxO) error {
+	bs, err := utxo.Bytes()
+	if err != nil {
+		return err
+	}
+	return b.tx.Bucket(utxosBucket).Put([]byte(h), bs)
+}
+
+func (b boltBatch) DeleteUTxO(h string) error {
+	return b.tx.Bucket(utxosBucket).Delete([]byte(h))
+}
+
+func (b boltBatch) PutTip(tip Tip) error {
+	bs, err := tip.Bytes()
+	if err != nil {
+		return err
+	}
+	return b.tx.Bucket(metaBucket).Put(tipKey, bs)
+}
+
+func (s *Bolt) Update(fn func(Batch) error) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return fn(boltBatch{tx: tx})
+	})
+}
+
+func (s *Bolt) get(bucket []byte, k []byte) ([]byte, error) {
+	var bs []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(bucket).Get(k)
+		if v == nil {
+			return ErrNotFound
+		}
+		bs = append([]byte(nil), v...)
+		return nil
+	})
+	return bs, err
+}
+
+func (s *Bolt) GetBlock(h string) (*block.Block, error) {
+	bs, err := s.get(blocksBucket, []byte(h))
+	if err != nil {
+		return nil, err
+	}
+	b, err := block.DecodeBlock(bs)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (s *Bolt) GetChainWork(h string) (*big.Int, error) {
+	bs, err := s.get(worksBucket, []byte(h))
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(bs), nil
+}
+
+func (s *Bolt) GetUndo(h string) (transaction.UTxOSlice, error) {
+	bs, err := s.get(undosBucket, []byte(h))
+	if err != nil {
+		return nil, err
+	}
+	return transaction.DecodeUTxOSlice(bs)
+}
+
+func (s *Bolt) GetBlockHashByHeight(height int64) (string, error) {
+	bs, err := s.get(heightsBucket, heightKey(height))
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+func (s *Bolt) GetTxBlockHash(txh string) (string, error) {
+	bs, err := s.get(txsBucket, []byte(txh))
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+func (s *Bolt) GetUTxOs() (transaction.UTxOMap, error) {
+	utxos := make(transaction.UTxOMap)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(utxosBucket).ForEach(func(k, v []byte) error {
+			utxo, err := transaction.DecodeUTxO(v)
+			if err != nil {
+				return err
+			}
+			utxos[string(k)] = utxo
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utxos, nil
+}
+
+func (s *Bolt) GetTip() (*Tip, error) {
+	bs, err := s.get(metaBucket, tipKey)
+	if err != nil {
+		return nil, err
+	}
+	tip, err := DecodeTip(bs)
+	if err != nil {
+		return nil, err
+	}
+	return &tip, nil
+}
+
+func (s *Bolt) Close() error {
+	return s.db.Close()
+}
+
+func NewBolt(dir string) (*Bolt, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	db, err := bolt.Open(filepath.Join(dir, "chain.db"), 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{blocksBucket, worksBucket, undosBucket, heightsBucket, txsBucket, utxosBucket, metaBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &Bolt{db: db}, nil
+}
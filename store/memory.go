@@ -0,0 +1,172 @@
+package store
+
+import (
+	"maps"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/guiferpa/jackiechain/block"
+	"github.com/guiferpa/jackiechain/transaction"
+)
+
+type Memory struct {
+	mu       sync.RWMutex
+	blocks   block.BlockMap
+	works    map[string]*big.Int
+	undos    map[string]transaction.UTxOSlice
+	heights  map[int64]string
+	txBlocks map[string]string
+	utxos    transaction.UTxOMap
+	tip      *Tip
+}
+
+type memoryBatch struct {
+	ops []func(m *Memory)
+}
+
+func (b *memoryBatch) PutBlock(h string, blk block.Block) error {
+	b.ops = append(b.ops, func(m *Memory) { m.blocks[h] = blk })
+	return nil
+}
+
+func (b *memoryBatch) PutChainWork(h string, work *big.Int) error {
+	work = new(big.Int).Set(work)
+	b.ops = append(b.ops, func(m *Memory) { m.works[h] = work })
+	return nil
+}
+
+func (b *memoryBatch) PutUndo(h string, spent transaction.UTxOSlice) error {
+	spent = slices.Clone(spent)
+	b.ops = append(b.ops, func(m *Memory) { m.undos[h] = spent })
+	return nil
+}
+
+func (b *memoryBatch) PutBlockHashByHeight(height int64, h string) error {
+	b.ops = append(b.ops, func(m *Memory) { m.heights[height] = h })
+	return nil
+}
+
+func (b *memoryBatch) DeleteBlockHashByHeight(height int64) error {
+	b.ops = append(b.ops, func(m *Memory) { delete(m.heights, height) })
+	return nil
+}
+
+func (b *memoryBatch) PutTxBlockHash(txh string, bh string) error {
+	b.ops = append(b.ops, func(m *Memory) { m.txBlocks[txh] = bh })
+	return nil
+}
+
+func (b *memoryBatch) DeleteTxBlockHash(txh string) error {
+	b.ops = append(b.ops, func(m *Memory) { delete(m.txBlocks, txh) })
+	return nil
+}
+
+func (b *memoryBatch) PutUTxO(h string, utxo transaction.UTxO) error {
+	b.ops = append(b.ops, func(m *Memory) { m.utxos[h] = utxo })
+	return nil
+}
+
+func (b *memoryBatch) DeleteUTxO(h string) error {
+	b.ops = append(b.ops, func(m *Memory) { delete(m.utxos, h) })
+	return nil
+}
+
+func (b *memoryBatch) PutTip(tip Tip) error {
+	b.ops = append(b.ops, func(m *Memory) { m.tip = &tip })
+	return nil
+}
+
+func (m *Memory) Update(fn func(Batch) error) error {
+	b := &memoryBatch{}
+	if err := fn(b); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, op := range b.ops {
+		op(m)
+	}
+	return nil
+}
+
+func (m *Memory) GetBlock(h string) (*block.Block, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	b, ok := m.blocks[h]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &b, nil
+}
+
+func (m *Memory) GetChainWork(h string) (*big.Int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	work, ok := m.works[h]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return new(big.Int).Set(work), nil
+}
+
+func (m *Memory) GetUndo(h string) (transaction.UTxOSlice, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	spent, ok := m.undos[h]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return slices.Clone(spent), nil
+}
+
+func (m *Memory) GetBlockHashByHeight(height int64) (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	h, ok := m.heights[height]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return h, nil
+}
+
+func (m *Memory) GetTxBlockHash(txh string) (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	bh, ok := m.txBlocks[txh]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return bh, nil
+}
+
+func (m *Memory) GetUTxOs() (transaction.UTxOMap, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return maps.Clone(m.utxos), nil
+}
+
+func (m *Memory) GetTip() (*Tip, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.tip == nil {
+		return nil, ErrNotFound
+	}
+	tip := *m.tip
+	return &tip, nil
+}
+
+func (m *Memory) Close() error {
+	return nil
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		blocks:   make(block.BlockMap),
+		works:    make(map[string]*big.Int),
+		undos:    make(map[string]transaction.UTxOSlice),
+		heights:  make(map[int64]string),
+		txBlocks: make(map[string]string),
+		utxos:    make(transaction.UTxOMap),
+	}
+}
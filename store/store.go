@@ -0,0 +1,60 @@
+package store
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/guiferpa/jackiechain/block"
+	"github.com/guiferpa/jackiechain/codec"
+	"github.com/guiferpa/jackiechain/transaction"
+)
+
+var ErrNotFound = errors.New("store: not found")
+
+type Tip struct {
+	Hash   string `json:"hash"`
+	Height int64  `json:"height"`
+}
+
+func (tip Tip) Bytes() ([]byte, error) {
+	w := codec.NewWriter()
+	w.String(tip.Hash)
+	w.Int64(tip.Height)
+	return w.Bytes()
+}
+
+func DecodeTip(bs []byte) (Tip, error) {
+	r := codec.NewReader(bs)
+	var tip Tip
+	tip.Hash = r.String()
+	tip.Height = r.Int64()
+	if err := r.Close(); err != nil {
+		return Tip{}, err
+	}
+	return tip, nil
+}
+
+type Batch interface {
+	PutBlock(h string, b block.Block) error
+	PutChainWork(h string, work *big.Int) error
+	PutUndo(h string, spent transaction.UTxOSlice) error
+	PutBlockHashByHeight(height int64, h string) error
+	DeleteBlockHashByHeight(height int64) error
+	PutTxBlockHash(txh string, bh string) error
+	DeleteTxBlockHash(txh string) error
+	PutUTxO(h string, utxo transaction.UTxO) error
+	DeleteUTxO(h string) error
+	PutTip(tip Tip) error
+}
+
+type Store interface {
+	Update(fn func(Batch) error) error
+	GetBlock(h string) (*block.Block, error)
+	GetChainWork(h string) (*big.Int, error)
+	GetUndo(h string) (transaction.UTxOSlice, error)
+	GetBlockHashByHeight(height int64) (string, error)
+	GetTxBlockHash(txh string) (string, error)
+	GetUTxOs() (transaction.UTxOMap, error)
+	GetTip() (*Tip, error)
+	Close() error
+}
@@ -0,0 +1,170 @@
+package transaction
+
+import "github.com/guiferpa/jackiechain/codec"
+
+// Smallest encodings of each element, with every string and byte field empty.
+const (
+	minOutPointSize = 4 + 4
+	minTxInSize     = minOutPointSize + 4 + 4
+	minTxOutSize    = 4 + 8
+	minUTxOSize     = minOutPointSize + minTxOutSize
+	MinTxSize       = 1 + 4 + 4 + 8 + 8 + 8
+)
+
+func writeOutPoint(w *codec.Writer, op OutPoint) {
+	w.String(op.TxHash)
+	w.Len(op.Index)
+}
+
+func readOutPoint(r *codec.Reader) OutPoint {
+	var op OutPoint
+	op.TxHash = r.String()
+	op.Index = int(r.Uint32())
+	return op
+}
+
+func writeTxIn(w *codec.Writer, txin TxIn) {
+	writeOutPoint(w, txin.PreviousOutPoint)
+	w.String(txin.PublicKey)
+	w.VarBytes(txin.Signature)
+}
+
+func readTxIn(r *codec.Reader) TxIn {
+	var txin TxIn
+	txin.PreviousOutPoint = readOutPoint(r)
+	txin.PublicKey = r.String()
+	txin.Signature = r.VarBytes()
+	return txin
+}
+
+func writeTxOut(w *codec.Writer, txo TxOut) {
+	w.String(txo.Receiver)
+	w.Int64(txo.Value)
+}
+
+func readTxOut(r *codec.Reader) TxOut {
+	var txo TxOut
+	txo.Receiver = r.String()
+	txo.Value = r.Int64()
+	return txo
+}
+
+func writeTx(w *codec.Writer, tx Tx) {
+	w.Len(len(tx.TxIns))
+	for _, txin := range tx.TxIns {
+		writeTxIn(w, txin)
+	}
+	w.Len(len(tx.TxOuts))
+	for _, txo := range tx.TxOuts {
+		writeTxOut(w, txo)
+	}
+	w.Int64(tx.Timestamp)
+	w.Int64(tx.Height)
+	w.Uint64(tx.ExtraNonce)
+}
+
+func readTx(r *codec.Reader) Tx {
+	var tx Tx
+	if n := r.Count(minTxInSize); n > 0 {
+		tx.TxIns = make(TxInSlice, n)
+		for i := range tx.TxIns {
+			tx.TxIns[i] = readTxIn(r)
+		}
+	}
+	if n := r.Count(minTxOutSize); n > 0 {
+		tx.TxOuts = make(TxOutSlice, n)
+		for i := range tx.TxOuts {
+			tx.TxOuts[i] = readTxOut(r)
+		}
+	}
+	tx.Timestamp = r.Int64()
+	tx.Height = r.Int64()
+	tx.ExtraNonce = r.Uint64()
+	return tx
+}
+
+func (op OutPoint) Bytes() ([]byte, error) {
+	w := codec.NewWriter()
+	writeOutPoint(w, op)
+	return w.Bytes()
+}
+
+func DecodeOutPoint(bs []byte) (OutPoint, error) {
+	r := codec.NewReader(bs)
+	op := readOutPoint(r)
+	if err := r.Close(); err != nil {
+		return OutPoint{}, err
+	}
+	return op, nil
+}
+
+func (txo TxOut) Bytes() ([]byte, error) {
+	w := codec.NewWriter()
+	writeTxOut(w, txo)
+	return w.Bytes()
+}
+
+func DecodeTxOut(bs []byte) (TxOut, error) {
+	r := codec.NewReader(bs)
+	txo := readTxOut(r)
+	if err := r.Close(); err != nil {
+		return TxOut{}, err
+	}
+	return txo, nil
+}
+
+func (utxo UTxO) Bytes() ([]byte, error) {
+	w := codec.NewWriter()
+	writeOutPoint(w, utxo.OutPoint)
+	writeTxOut(w, TxOut{Receiver: utxo.Receiver, Value: utxo.Value})
+	return w.Bytes()
+}
+
+func DecodeUTxO(bs []byte) (UTxO, error) {
+	r := codec.NewReader(bs)
+	op := readOutPoint(r)
+	txo := readTxOut(r)
+	if err := r.Close(); err != nil {
+		return UTxO{}, err
+	}
+	return UTxO{OutPoint: op, Receiver: txo.Receiver, Value: txo.Value}, nil
+}
+
+func (utxos UTxOSlice) Bytes() ([]byte, error) {
+	w := codec.NewWriter()
+	w.Len(len(utxos))
+	for _, utxo := range utxos {
+		writeOutPoint(w, utxo.OutPoint)
+		writeTxOut(w, TxOut{Receiver: utxo.Receiver, Value: utxo.Value})
+	}
+	return w.Bytes()
+}
+
+func DecodeUTxOSlice(bs []byte) (UTxOSlice, error) {
+	r := codec.NewReader(bs)
+	utxos := make(UTxOSlice, r.Count(minUTxOSize))
+	for i := range utxos {
+		op := readOutPoint(r)
+		txo := readTxOut(r)
+		utxos[i] = UTxO{OutPoint: op, Receiver: txo.Receiver, Value: txo.Value}
+	}
+	if err := r.Close(); err != nil {
+		return nil, err
+	}
+	return utxos, nil
+}
+
+func (tx Tx) Bytes() ([]byte, error) {
+	w := codec.NewWriter()
+	writeTx(w, tx)
+	return w.Bytes()
+}
+
+func DecodeTx(bs []byte) (Tx, error) {
+	r := codec.NewReader(bs)
+	tx := readTx(r)
+	if err := r.Close(); err != nil {
+		return Tx{}, err
+	}
+	return tx, nil
+}
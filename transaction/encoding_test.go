@@ -0,0 +1,60 @@
+package transaction
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/guiferpa/jackiechain/codec"
+)
+
+func TestTxRoundTrip(t *testing.T) {
+	cases := map[string]Tx{
+		"empty":    {},
+		"coinbase": NewCoinbaseTx("receiver", 50, 7),
+		"spend": {
+			TxIns: TxInSlice{
+				{PreviousOutPoint: OutPoint{TxHash: "a1", Index: 0}, PublicKey: "pub", Signature: []byte{1, 2, 3}},
+				{PreviousOutPoint: OutPoint{TxHash: "b2", Index: 3}, PublicKey: "pub", Signature: []byte{4}},
+			},
+			TxOuts:     TxOutSlice{{Receiver: "alice", Value: 10}, {Receiver: "bob", Value: 0}},
+			Timestamp:  1700000000,
+			ExtraNonce: 9,
+		},
+	}
+	for name, tx := range cases {
+		t.Run(name, func(t *testing.T) {
+			bs, err := tx.Bytes()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := DecodeTx(bs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tx) {
+				t.Fatalf("got %+v, want %+v", got, tx)
+			}
+		})
+	}
+}
+
+func TestDecodeTxRejectsMalformedInput(t *testing.T) {
+	bs, err := NewCoinbaseTx("receiver", 50, 7).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DecodeTx(bs[:len(bs)-1]); !errors.Is(err, codec.ErrShortBuffer) {
+		t.Fatalf("got error %v for a truncated tx, want %v", err, codec.ErrShortBuffer)
+	}
+	if _, err := DecodeTx(append(bs, 0)); !errors.Is(err, codec.ErrTrailingBytes) {
+		t.Fatalf("got error %v for trailing bytes, want %v", err, codec.ErrTrailingBytes)
+	}
+	forged := append([]byte{codec.Version}, 0xff, 0xff, 0xff, 0xff)
+	if _, err := DecodeTx(forged); !errors.Is(err, codec.ErrShortBuffer) {
+		t.Fatalf("got error %v for a forged input count, want %v", err, codec.ErrShortBuffer)
+	}
+	if _, err := DecodeUTxOSlice(forged); !errors.Is(err, codec.ErrShortBuffer) {
+		t.Fatalf("got error %v for a forged utxo count, want %v", err, codec.ErrShortBuffer)
+	}
+}
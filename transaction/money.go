@@ -0,0 +1,21 @@
+package transaction
+
+import (
+	"errors"
+	"fmt"
+)
+
+const MaxMoney int64 = 21_000_000
+
+var ErrMoneyOutOfRange = errors.New("value is out of the money range")
+
+func IsMoneyRange(v int64) bool {
+	return v >= 0 && v <= MaxMoney
+}
+
+func AddMoney(a, b int64) (int64, error) {
+	if !IsMoneyRange(a) || !IsMoneyRange(b) || a > MaxMoney-b {
+		return 0, fmt.Errorf("%w: %d + %d", ErrMoneyOutOfRange, a, b)
+	}
+	return a + b, nil
+}
@@ -4,24 +4,41 @@ import (
 	"crypto/ed25519"
 	"crypto/sha256"
 	"encoding/hex"
-	"encoding/json"
+	"errors"
+	"time"
 
 	"github.com/mr-tron/base58"
 )
 
 type Tx struct {
-	Sender    string     `json:"sender"`
-	TxOuts    TxOutSlice `json:"tx_outs"`
-	Signature []byte     `json:"signature"`
-	Timestamp int64      `json:"timestamp"`
+	TxIns      TxInSlice  `json:"tx_ins"`
+	TxOuts     TxOutSlice `json:"tx_outs"`
+	Timestamp  int64      `json:"timestamp"`
+	Height     int64      `json:"height,omitempty"`
+	ExtraNonce uint64     `json:"extra_nonce,omitempty"`
 }
 
-func (tx Tx) Bytes() ([]byte, error) {
-	bs, err := json.Marshal(&tx)
-	if err != nil {
-		return nil, err
+func (tx Tx) OutputsValue() (int64, error) {
+	var v int64
+	for _, txo := range tx.TxOuts {
+		var err error
+		if v, err = AddMoney(v, txo.Value); err != nil {
+			return 0, err
+		}
+	}
+	return v, nil
+}
+
+func (tx Tx) IsCoinbase() bool {
+	return len(tx.TxIns) == 0
+}
+
+func NewCoinbaseTx(receiver string, value int64, height int64) Tx {
+	return Tx{
+		TxOuts:    TxOutSlice{{Receiver: receiver, Value: value}},
+		Timestamp: time.Now().UnixNano(),
+		Height:    height,
 	}
-	return bs, nil
 }
 
 type TxSlice []Tx
@@ -48,7 +65,31 @@ func (txm TxMap) ToSlice() TxSlice {
 	return txs
 }
 
+const SigHashTag = "jackiechain/sighash/v1"
+
+func withoutSignatures(tx Tx) Tx {
+	stx := tx
+	if tx.TxIns != nil {
+		stx.TxIns = make(TxInSlice, len(tx.TxIns))
+		for i, txin := range tx.TxIns {
+			txin.Signature = nil
+			stx.TxIns[i] = txin
+		}
+	}
+	return stx
+}
+
 func GenerateTxHash(tx Tx) (string, error) {
+	bs, err := withoutSignatures(tx).Bytes()
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write(bs)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func GenerateWitnessHash(tx Tx) (string, error) {
 	bs, err := tx.Bytes()
 	if err != nil {
 		return "", err
@@ -58,24 +99,43 @@ func GenerateTxHash(tx Tx) (string, error) {
 	return hex.EncodeToString(h.Sum(nil)), nil
 }
 
-func SignTx(tx Tx, privkey ed25519.PrivateKey) ([]byte, error) {
-	s256 := sha256.New()
-	h, err := GenerateTxHash(tx)
+func GenerateSigHash(tx Tx) ([]byte, error) {
+	bs, err := withoutSignatures(tx).Bytes()
 	if err != nil {
 		return nil, err
 	}
-	s256.Write([]byte(h))
-	return ed25519.Sign(privkey, s256.Sum(nil)), nil
+	h := sha256.New()
+	h.Write([]byte(SigHashTag))
+	h.Write(bs)
+	return h.Sum(nil), nil
 }
 
-func TxHasValidSignature(tx Tx) (bool, error) {
-	b, err := base58.Decode(tx.Sender)
+func SignTxIn(tx Tx, index int, privkey ed25519.PrivateKey) ([]byte, error) {
+	if index < 0 || index >= len(tx.TxIns) {
+		return nil, errors.New("tx input index out of range")
+	}
+	h, err := GenerateSigHash(tx)
+	if err != nil {
+		return nil, err
+	}
+	return ed25519.Sign(privkey, h), nil
+}
+
+func TxInHasValidSignature(tx Tx, index int) (bool, error) {
+	if index < 0 || index >= len(tx.TxIns) {
+		return false, errors.New("tx input index out of range")
+	}
+	txin := tx.TxIns[index]
+	b, err := base58.Decode(txin.PublicKey)
 	if err != nil {
 		return false, err
 	}
-	h, err := GenerateTxHash(tx)
+	if len(b) != ed25519.PublicKeySize || len(txin.Signature) != ed25519.SignatureSize {
+		return false, nil
+	}
+	h, err := GenerateSigHash(tx)
 	if err != nil {
 		return false, err
 	}
-	return ed25519.Verify(b, []byte(h), tx.Signature[:ed25519.SignatureSize]), nil
+	return ed25519.Verify(b, h, txin.Signature), nil
 }
@@ -0,0 +1,9 @@
+package transaction
+
+type TxIn struct {
+	PreviousOutPoint OutPoint `json:"previous_out_point"`
+	PublicKey        string   `json:"public_key"`
+	Signature        []byte   `json:"signature"`
+}
+
+type TxInSlice []TxIn
@@ -7,8 +7,9 @@ type TxOut struct {
 
 type TxOutSlice []TxOut
 
-func GenerateUTxOFromTxOut(txo TxOut) UTxO {
+func GenerateUTxOFromTxOut(txh string, index int, txo TxOut) UTxO {
 	return UTxO{
+		OutPoint: OutPoint{TxHash: txh, Index: index},
 		Receiver: txo.Receiver,
 		Value:    txo.Value,
 	}
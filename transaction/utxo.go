@@ -3,25 +3,34 @@ package transaction
 import (
 	"crypto/sha256"
 	"encoding/hex"
-	"encoding/json"
 )
 
-type UTxO struct {
-	Sender   string `json:"sender"`
-	Receiver string `json:"receiver"`
-	TxHash   string `json:"tx_hash"`
-	Value    int64  `json:"value"`
+type OutPoint struct {
+	TxHash string `json:"tx_hash"`
+	Index  int    `json:"index"`
 }
 
-func (utxo UTxO) Bytes() ([]byte, error) {
-	bs, err := json.Marshal(&utxo)
+func GenerateOutPointHash(op OutPoint) (string, error) {
+	bs, err := op.Bytes()
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	return bs, nil
+	h := sha256.New()
+	h.Write(bs)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+type UTxO struct {
+	OutPoint
+	Receiver string `json:"receiver"`
+	Value    int64  `json:"value"`
 }
 
 func GenerateUTxOHash(utxo UTxO) (string, error) {
+	return GenerateOutPointHash(utxo.OutPoint)
+}
+
+func GenerateUTxOValueHash(utxo UTxO) (string, error) {
 	bs, err := utxo.Bytes()
 	if err != nil {
 		return "", err
@@ -0,0 +1,110 @@
+package wallet
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	HardenedOffset uint32 = 0x80000000
+	BIP44Purpose   uint32 = 44
+	CoinType       uint32 = 1
+)
+
+var (
+	ErrNonHardenedIndex      = errors.New("ed25519 only supports hardened derivation")
+	ErrInvalidDerivationPath = errors.New("derivation path is invalid")
+)
+
+type HDKey struct {
+	Key       []byte
+	ChainCode []byte
+}
+
+func hmacSHA512(key, data []byte) ([]byte, []byte) {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	i := mac.Sum(nil)
+	return i[:32], i[32:]
+}
+
+func NewMasterKey(seed []byte) *HDKey {
+	k, c := hmacSHA512([]byte("ed25519 seed"), seed)
+	return &HDKey{Key: k, ChainCode: c}
+}
+
+func (k *HDKey) Derive(index uint32) (*HDKey, error) {
+	if index < HardenedOffset {
+		return nil, fmt.Errorf("%w: index %d", ErrNonHardenedIndex, index)
+	}
+	data := make([]byte, 0, 37)
+	data = append(data, 0x00)
+	data = append(data, k.Key...)
+	data = binary.BigEndian.AppendUint32(data, index)
+	ck, cc := hmacSHA512(k.ChainCode, data)
+	return &HDKey{Key: ck, ChainCode: cc}, nil
+}
+
+func (k *HDKey) Wallet() *Wallet {
+	priv := ed25519.NewKeyFromSeed(k.Key)
+	return &Wallet{
+		PrivateKey: priv,
+		PublicKey:  priv.Public().(ed25519.PublicKey),
+	}
+}
+
+func ParseDerivationPath(path string) ([]uint32, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) == 0 || parts[0] != "m" {
+		return nil, fmt.Errorf("%w: %q doesn't start at m", ErrInvalidDerivationPath, path)
+	}
+	indexes := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		hardened := strings.HasSuffix(part, "'") || strings.HasSuffix(part, "H")
+		if !hardened {
+			return nil, fmt.Errorf("%w: %q", ErrNonHardenedIndex, part)
+		}
+		i, err := strconv.ParseUint(part[:len(part)-1], 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidDerivationPath, part)
+		}
+		indexes = append(indexes, uint32(i)+HardenedOffset)
+	}
+	return indexes, nil
+}
+
+func DerivationPath(account, index uint32) string {
+	return fmt.Sprintf("m/%d'/%d'/%d'/0'/%d'", BIP44Purpose, CoinType, account, index)
+}
+
+func DeriveKey(seed []byte, path string) (*HDKey, error) {
+	indexes, err := ParseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+	k := NewMasterKey(seed)
+	for _, i := range indexes {
+		if k, err = k.Derive(i); err != nil {
+			return nil, err
+		}
+	}
+	return k, nil
+}
+
+func NewWalletFromMnemonic(mnemonic, passphrase string, account, index uint32) (*Wallet, error) {
+	seed, err := MnemonicToSeed(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	k, err := DeriveKey(seed, DerivationPath(account, index))
+	if err != nil {
+		return nil, err
+	}
+	return k.Wallet(), nil
+}
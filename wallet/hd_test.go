@@ -0,0 +1,103 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// Test vectors from the BIP-39 reference implementation and SLIP-0010.
+
+func TestMnemonicVectors(t *testing.T) {
+	cases := []struct {
+		entropy  string
+		mnemonic string
+		seed     string
+	}{
+		{
+			entropy:  "00000000000000000000000000000000",
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			seed:     "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		},
+		{
+			entropy:  "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+			mnemonic: "legal winner thank year wave sausage worth useful legal winner thank yellow",
+			seed:     "2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+		},
+		{
+			entropy:  "80808080808080808080808080808080",
+			mnemonic: "letter advice cage absurd amount doctor acoustic avoid letter advice cage above",
+			seed:     "d71de856f81a8acc65e6fc851a38d4d7ec216fd0796d0a6827a3ad6ed5511a30fa280f12eb2e47ed2ac03b5c462a0358d18d69fe4f985ec81778c1b370b652a8",
+		},
+		{
+			entropy:  "ffffffffffffffffffffffffffffffff",
+			mnemonic: "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong",
+			seed:     "ac27495480225222079d7be181583751e86f571027b0497b5b5d11218e0a8a13332572917f0f8e5a589620c6f15b11c61dee327651a14c34e18231052e48c069",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.entropy, func(t *testing.T) {
+			entropy, err := hex.DecodeString(c.entropy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			mnemonic, err := EntropyToMnemonic(entropy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if mnemonic != c.mnemonic {
+				t.Fatalf("got mnemonic %q, want %q", mnemonic, c.mnemonic)
+			}
+			got, err := MnemonicToEntropy(c.mnemonic)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(got) != c.entropy {
+				t.Fatalf("got entropy %x, want %s", got, c.entropy)
+			}
+			seed, err := MnemonicToSeed(c.mnemonic, "TREZOR")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(seed) != c.seed {
+				t.Fatalf("got seed %x, want %s", seed, c.seed)
+			}
+		})
+	}
+}
+
+func TestSLIP10Vector1(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		path      string
+		chainCode string
+		key       string
+		publicKey string
+	}{
+		{"m", "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb", "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7", "a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed"},
+		{"m/0'", "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69", "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", "8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c"},
+		{"m/0'/1'", "a320425f77d1b5c2505a6b1b27382b37368ee640e3557c315416801243552f14", "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2", "1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"},
+		{"m/0'/1'/2'", "2e69929e00b5ab250f49c3fb1c12f252de4fed2c1db88387094a0f8c4c9ccd6c", "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9", "ae98736566d30ed0e9d2f4486a64bc95740d89c7db33f52121f8ea8f76ff0fc1"},
+		{"m/0'/1'/2'/2'", "8f6d87f93d750e0efccda017d662a1b31a266e4a6f5993b15f5c1f07f74dd5cc", "30d1dc7e5fc04c31219ab25a27ae00b50f6fd66622f6e9c913253d6511d1e662", "8abae2d66361c879b900d204ad2cc4984fa2aa344dd7ddc46007329ac76c429c"},
+		{"m/0'/1'/2'/2'/1000000000'", "68789923a0cac2cd5a29172a475fe9e0fb14cd6adb5ad98a3fa70333e7afa230", "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793", "3c24da049451555d51a7014a37337aa4e12d41e485abccfa46b47dfb2af54b7a"},
+	}
+	for _, c := range cases {
+		t.Run(c.path, func(t *testing.T) {
+			k, err := DeriveKey(seed, c.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := hex.EncodeToString(k.ChainCode); got != c.chainCode {
+				t.Fatalf("got chain code %s, want %s", got, c.chainCode)
+			}
+			if got := hex.EncodeToString(k.Key); got != c.key {
+				t.Fatalf("got key %s, want %s", got, c.key)
+			}
+			if got := hex.EncodeToString(k.Wallet().PublicKey); got != c.publicKey {
+				t.Fatalf("got public key %s, want %s", got, c.publicKey)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package wallet
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	KeystoreVersion = 1
+	KeystoreKDF     = "argon2id"
+	KeystoreCipher  = "aes-256-gcm"
+	KeystoreSalt    = 16
+	MaxKDFTime      = 64
+	MaxKDFMemory    = 4 * 1024 * 1024
+)
+
+var (
+	ErrInvalidPassphrase = errors.New("keystore passphrase is invalid")
+	ErrInvalidKeystore   = errors.New("keystore is invalid")
+)
+
+type KDFParams struct {
+	Salt    []byte `json:"salt"`
+	Time    uint32 `json:"time"`
+	Memory  uint32 `json:"memory"`
+	Threads uint8  `json:"threads"`
+}
+
+type Keystore struct {
+	Version    int       `json:"version"`
+	Address    string    `json:"address"`
+	KDF        string    `json:"kdf"`
+	KDFParams  KDFParams `json:"kdf_params"`
+	Cipher     string    `json:"cipher"`
+	Nonce      []byte    `json:"nonce"`
+	Ciphertext []byte    `json:"ciphertext"`
+}
+
+var DefaultKDFParams = KDFParams{Time: 3, Memory: 64 * 1024, Threads: 4}
+
+func validateKDFParams(params KDFParams) error {
+	if len(params.Salt) != KeystoreSalt {
+		return fmt.Errorf("%w: kdf salt has %d bytes", ErrInvalidKeystore, len(params.Salt))
+	}
+	if params.Time < 1 || params.Time > MaxKDFTime {
+		return fmt.Errorf("%w: kdf time %d is out of range", ErrInvalidKeystore, params.Time)
+	}
+	if params.Memory > MaxKDFMemory {
+		return fmt.Errorf("%w: kdf memory %d KiB is out of range", ErrInvalidKeystore, params.Memory)
+	}
+	if params.Threads < 1 {
+		return fmt.Errorf("%w: kdf threads %d is out of range", ErrInvalidKeystore, params.Threads)
+	}
+	return nil
+}
+
+func newKeystoreAEAD(passphrase []byte, params KDFParams) (cipher.AEAD, error) {
+	key := argon2.IDKey(passphrase, params.Salt, params.Time, params.Memory, params.Threads, 32)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func EncryptKeystore(w *Wallet, passphrase []byte) (*Keystore, error) {
+	params := DefaultKDFParams
+	params.Salt = make([]byte, KeystoreSalt)
+	if _, err := rand.Read(params.Salt); err != nil {
+		return nil, err
+	}
+	aead, err := newKeystoreAEAD(passphrase, params)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	address := w.GetAddress()
+	return &Keystore{
+		Version:    KeystoreVersion,
+		Address:    address,
+		KDF:        KeystoreKDF,
+		KDFParams:  params,
+		Cipher:     KeystoreCipher,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, w.PrivateKey.Seed(), []byte(address)),
+	}, nil
+}
+
+func DecryptKeystore(ks *Keystore, passphrase []byte) (*Wallet, error) {
+	if ks.Version != KeystoreVersion || ks.KDF != KeystoreKDF || ks.Cipher != KeystoreCipher {
+		return nil, fmt.Errorf("%w: unsupported version %d with %s and %s", ErrInvalidKeystore, ks.Version, ks.KDF, ks.Cipher)
+	}
+	if err := validateKDFParams(ks.KDFParams); err != nil {
+		return nil, err
+	}
+	aead, err := newKeystoreAEAD(passphrase, ks.KDFParams)
+	if err != nil {
+		return nil, err
+	}
+	if len(ks.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("%w: nonce has %d bytes", ErrInvalidKeystore, len(ks.Nonce))
+	}
+	seed, err := aead.Open(nil, ks.Nonce, ks.Ciphertext, []byte(ks.Address))
+	if err != nil {
+		return nil, ErrInvalidPassphrase
+	}
+	w, err := NewWalletFromSeed(seed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKeystore, err)
+	}
+	if w.GetAddress() != ks.Address {
+		return nil, fmt.Errorf("%w: key doesn't match address %s", ErrInvalidKeystore, ks.Address)
+	}
+	return w, nil
+}
+
+func writeFileAtomic(path string, bs []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if err := f.Chmod(0o600); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err := f.Write(bs); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func SaveKeystore(path string, w *Wallet, passphrase []byte) error {
+	ks, err := EncryptKeystore(w, passphrase)
+	if err != nil {
+		return err
+	}
+	bs, err := json.MarshalIndent(ks, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, bs)
+}
+
+func LoadKeystore(path string, passphrase []byte) (*Wallet, error) {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var ks Keystore
+	if err := json.Unmarshal(bs, &ks); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKeystore, err)
+	}
+	return DecryptKeystore(&ks, passphrase)
+}
+
+func ChangeKeystorePassphrase(path string, oldPassphrase, newPassphrase []byte) error {
+	w, err := LoadKeystore(path, oldPassphrase)
+	if err != nil {
+		return err
+	}
+	return SaveKeystore(path, w, newPassphrase)
+}
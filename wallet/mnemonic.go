@@ -0,0 +1,107 @@
+package wallet
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	MnemonicSeedIterations = 2048
+	DefaultEntropyBits     = 256
+)
+
+var (
+	ErrInvalidEntropy  = errors.New("mnemonic entropy must be 128 to 256 bits in steps of 32")
+	ErrInvalidMnemonic = errors.New("mnemonic is invalid")
+)
+
+//go:embed wordlist_english.txt
+var englishWordlist string
+
+var (
+	mnemonicWords   = strings.Fields(englishWordlist)
+	mnemonicIndexes = func() map[string]int {
+		idx := make(map[string]int, len(mnemonicWords))
+		for i, w := range mnemonicWords {
+			idx[w] = i
+		}
+		return idx
+	}()
+)
+
+func EntropyToMnemonic(entropy []byte) (string, error) {
+	bits := len(entropy) * 8
+	if bits < 128 || bits > 256 || bits%32 != 0 {
+		return "", ErrInvalidEntropy
+	}
+	cs := bits / 32
+	h := sha256.Sum256(entropy)
+	n := new(big.Int).SetBytes(entropy)
+	n.Lsh(n, uint(cs))
+	n.Or(n, big.NewInt(int64(h[0]>>(8-cs))))
+	words := make([]string, (bits+cs)/11)
+	mask := big.NewInt(2047)
+	for i := len(words) - 1; i >= 0; i-- {
+		words[i] = mnemonicWords[new(big.Int).And(n, mask).Int64()]
+		n.Rsh(n, 11)
+	}
+	return strings.Join(words, " "), nil
+}
+
+func MnemonicToEntropy(mnemonic string) ([]byte, error) {
+	words := strings.Fields(norm.NFKD.String(mnemonic))
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return nil, fmt.Errorf("%w: %d words", ErrInvalidMnemonic, len(words))
+	}
+	n := new(big.Int)
+	for _, w := range words {
+		i, ok := mnemonicIndexes[w]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown word %q", ErrInvalidMnemonic, w)
+		}
+		n.Lsh(n, 11)
+		n.Or(n, big.NewInt(int64(i)))
+	}
+	cs := len(words) * 11 / 33
+	checksum := byte(new(big.Int).And(n, big.NewInt(int64(1<<cs-1))).Int64())
+	n.Rsh(n, uint(cs))
+	entropy := n.FillBytes(make([]byte, cs*4))
+	if h := sha256.Sum256(entropy); h[0]>>(8-cs) != checksum {
+		return nil, fmt.Errorf("%w: checksum mismatch", ErrInvalidMnemonic)
+	}
+	return entropy, nil
+}
+
+func NewMnemonic(bits int) (string, error) {
+	if bits < 128 || bits > 256 || bits%32 != 0 {
+		return "", ErrInvalidEntropy
+	}
+	entropy := make([]byte, bits/8)
+	if _, err := rand.Read(entropy); err != nil {
+		return "", err
+	}
+	return EntropyToMnemonic(entropy)
+}
+
+func ValidateMnemonic(mnemonic string) error {
+	_, err := MnemonicToEntropy(mnemonic)
+	return err
+}
+
+func MnemonicToSeed(mnemonic, passphrase string) ([]byte, error) {
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+	password := norm.NFKD.String(strings.Join(strings.Fields(mnemonic), " "))
+	salt := norm.NFKD.String("mnemonic" + passphrase)
+	return pbkdf2.Key([]byte(password), []byte(salt), MnemonicSeedIterations, 64, sha512.New), nil
+}
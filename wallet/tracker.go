@@ -0,0 +1,211 @@
+package wallet
+
+import (
+	"sync"
+
+	"github.com/guiferpa/jackiechain/address"
+	"github.com/guiferpa/jackiechain/blockchain"
+	"github.com/guiferpa/jackiechain/transaction"
+)
+
+type Balance struct {
+	Confirmed   int64 `json:"confirmed"`
+	Unconfirmed int64 `json:"unconfirmed"`
+}
+
+func (b Balance) Total() int64 {
+	return b.Confirmed + b.Unconfirmed
+}
+
+type Tracker struct {
+	mu        sync.RWMutex
+	addresses map[string]struct{}
+	utxos     transaction.UTxOMap
+	pending   transaction.UTxOMap
+	spends    map[string]string
+}
+
+// NewTracker reads the chain and mempool, so call it under the same lock
+// that guards every other Blockchain call.
+func NewTracker(bc *blockchain.Blockchain, addresses ...string) (*Tracker, error) {
+	t := &Tracker{
+		addresses: make(map[string]struct{}),
+		utxos:     make(transaction.UTxOMap),
+		pending:   make(transaction.UTxOMap),
+		spends:    make(map[string]string),
+	}
+	for _, a := range addresses {
+		if err := address.ValidateNetworkAddress(a, bc.NetworkVersion); err != nil {
+			return nil, err
+		}
+		t.addresses[a] = struct{}{}
+	}
+	for utxoh, utxo := range bc.UTxOs {
+		if t.owns(utxo) {
+			t.utxos[utxoh] = utxo
+		}
+	}
+	for utxoh, utxo := range bc.PendingUTxOs {
+		if t.owns(utxo) {
+			t.pending[utxoh] = utxo
+		}
+	}
+	for utxoh, txh := range bc.PendingSpends {
+		t.spends[utxoh] = txh
+	}
+	bc.Subscribe(func(ev blockchain.Event) {
+		t.onEvent(bc, ev)
+	})
+	return t, nil
+}
+
+func (t *Tracker) Addresses() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	as := make([]string, 0, len(t.addresses))
+	for a := range t.addresses {
+		as = append(as, a)
+	}
+	return as
+}
+
+func (t *Tracker) owns(utxo transaction.UTxO) bool {
+	_, ok := t.addresses[utxo.Receiver]
+	return ok
+}
+
+func (t *Tracker) onEvent(bc *blockchain.Blockchain, ev blockchain.Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	switch ev.Type {
+	case blockchain.BlockConnected, blockchain.BlockDisconnected:
+		t.applyBlock(bc, ev)
+	case blockchain.TxAccepted:
+		t.applyPendingTx(ev.Hash, ev.Tx, true)
+	case blockchain.TxRemoved:
+		t.applyPendingTx(ev.Hash, ev.Tx, false)
+	}
+}
+
+func (t *Tracker) applyBlock(bc *blockchain.Blockchain, ev blockchain.Event) {
+	txhs, err := ev.Block.Transactions.GenerateTxHashes()
+	if err != nil {
+		return
+	}
+	for j, tx := range ev.Block.Transactions {
+		txh := txhs[j]
+		if ev.Type == blockchain.BlockConnected {
+			t.applyPendingTx(txh, tx, false)
+		}
+		for _, txin := range tx.TxIns {
+			utxoh, err := transaction.GenerateOutPointHash(txin.PreviousOutPoint)
+			if err != nil {
+				continue
+			}
+			switch ev.Type {
+			case blockchain.BlockConnected:
+				delete(t.utxos, utxoh)
+			case blockchain.BlockDisconnected:
+				if utxo, ok := bc.UTxOs[utxoh]; ok && t.owns(utxo) {
+					t.utxos[utxoh] = utxo
+				}
+			}
+		}
+		for i, txout := range tx.TxOuts {
+			utxo := transaction.GenerateUTxOFromTxOut(txh, i, txout)
+			if !t.owns(utxo) {
+				continue
+			}
+			utxoh, err := transaction.GenerateUTxOHash(utxo)
+			if err != nil {
+				continue
+			}
+			switch ev.Type {
+			case blockchain.BlockConnected:
+				if _, ok := bc.UTxOs[utxoh]; ok {
+					t.utxos[utxoh] = utxo
+				}
+			case blockchain.BlockDisconnected:
+				delete(t.utxos, utxoh)
+			}
+		}
+	}
+}
+
+func (t *Tracker) applyPendingTx(txh string, tx transaction.Tx, accepted bool) {
+	for _, txin := range tx.TxIns {
+		utxoh, err := transaction.GenerateOutPointHash(txin.PreviousOutPoint)
+		if err != nil {
+			continue
+		}
+		if accepted {
+			t.spends[utxoh] = txh
+		} else if t.spends[utxoh] == txh {
+			delete(t.spends, utxoh)
+		}
+	}
+	for i, txout := range tx.TxOuts {
+		utxo := transaction.GenerateUTxOFromTxOut(txh, i, txout)
+		if !t.owns(utxo) {
+			continue
+		}
+		utxoh, err := transaction.GenerateUTxOHash(utxo)
+		if err != nil {
+			continue
+		}
+		if accepted {
+			t.pending[utxoh] = utxo
+		} else {
+			delete(t.pending, utxoh)
+		}
+	}
+}
+
+func (t *Tracker) UTxOs(a string) transaction.UTxOSlice {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	utxos := make(transaction.UTxOSlice, 0)
+	for _, utxo := range t.utxos {
+		if a == "" || utxo.Receiver == a {
+			utxos = append(utxos, utxo)
+		}
+	}
+	return utxos
+}
+
+func (t *Tracker) balance(a string) Balance {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	match := func(utxo transaction.UTxO) bool {
+		return a == "" || utxo.Receiver == a
+	}
+	var b Balance
+	for _, utxo := range t.utxos {
+		if match(utxo) {
+			b.Confirmed += utxo.Value
+		}
+	}
+	for _, utxo := range t.pending {
+		if match(utxo) {
+			b.Unconfirmed += utxo.Value
+		}
+	}
+	for utxoh := range t.spends {
+		utxo, ok := t.utxos[utxoh]
+		if !ok {
+			utxo, ok = t.pending[utxoh]
+		}
+		if ok && match(utxo) {
+			b.Unconfirmed -= utxo.Value
+		}
+	}
+	return b
+}
+
+func (t *Tracker) Balance() Balance {
+	return t.balance("")
+}
+
+func (t *Tracker) BalanceOf(a string) Balance {
+	return t.balance(a)
+}
@@ -1,25 +1,51 @@
 package wallet
 
 import (
+	"bytes"
 	"crypto/ed25519"
 	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
 
+	"github.com/guiferpa/jackiechain/address"
 	"github.com/mr-tron/base58"
 )
 
+const PEMBlockType = "PRIVATE KEY"
+
+var ErrInvalidPrivateKey = errors.New("wallet private key is invalid")
+
 type Wallet struct {
 	PrivateKey ed25519.PrivateKey
 	PublicKey  ed25519.PublicKey
 }
 
 func (w *Wallet) GetAddress() string {
+	return address.NewAddress(address.MainNetVersion, w.PublicKey).String()
+}
+
+func (w *Wallet) GetPublicKey() string {
 	return base58.Encode(w.PublicKey)
 }
 
 func (w *Wallet) GetPrivateSeed() string {
+	return base58.Encode(w.PrivateKey.Seed())
+}
+
+func (w *Wallet) GetPrivateKey() string {
 	return base58.Encode(w.PrivateKey)
 }
 
+func (w *Wallet) MarshalPEM() ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(w.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: PEMBlockType, Bytes: der}), nil
+}
+
 func NewWallet() (*Wallet, error) {
 	pub, priv, err := ed25519.GenerateKey(rand.Reader)
 	if err != nil {
@@ -31,15 +57,57 @@ func NewWallet() (*Wallet, error) {
 	}, nil
 }
 
-func ParseWallet(raw string) (*Wallet, error) {
-	seed, err := base58.Decode(raw)
-	if err != nil {
-		return nil, err
+func NewWalletFromSeed(seed []byte) (*Wallet, error) {
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("%w: seed has %d bytes, want %d", ErrInvalidPrivateKey, len(seed), ed25519.SeedSize)
 	}
 	priv := ed25519.NewKeyFromSeed(seed)
-	w := &Wallet{
+	return &Wallet{
 		PrivateKey: priv,
 		PublicKey:  priv.Public().(ed25519.PublicKey),
+	}, nil
+}
+
+func NewWalletFromPrivateKey(priv ed25519.PrivateKey) (*Wallet, error) {
+	if len(priv) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("%w: key has %d bytes, want %d", ErrInvalidPrivateKey, len(priv), ed25519.PrivateKeySize)
+	}
+	w, err := NewWalletFromSeed(priv.Seed())
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(w.PrivateKey, priv) {
+		return nil, fmt.Errorf("%w: public half doesn't match its seed", ErrInvalidPrivateKey)
 	}
 	return w, nil
 }
+
+func ParseWallet(raw string) (*Wallet, error) {
+	bs, err := base58.Decode(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+	switch len(bs) {
+	case ed25519.SeedSize:
+		return NewWalletFromSeed(bs)
+	case ed25519.PrivateKeySize:
+		return NewWalletFromPrivateKey(bs)
+	}
+	return nil, fmt.Errorf("%w: %d bytes is neither a seed nor a full key", ErrInvalidPrivateKey, len(bs))
+}
+
+func ParsePEM(bs []byte) (*Wallet, error) {
+	block, _ := pem.Decode(bs)
+	if block == nil || block.Type != PEMBlockType {
+		return nil, fmt.Errorf("%w: no %s PEM block", ErrInvalidPrivateKey, PEMBlockType)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+	priv, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T isn't an ed25519 key", ErrInvalidPrivateKey, key)
+	}
+	return NewWalletFromPrivateKey(priv)
+}
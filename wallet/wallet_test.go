@@ -0,0 +1,114 @@
+package wallet
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/mr-tron/base58"
+)
+
+func assertSameWallet(t *testing.T, got, want *Wallet) {
+	t.Helper()
+	if !bytes.Equal(got.PrivateKey, want.PrivateKey) || !bytes.Equal(got.PublicKey, want.PublicKey) {
+		t.Fatalf("wallet %s doesn't match %s", got.GetAddress(), want.GetAddress())
+	}
+	if got.GetAddress() != want.GetAddress() {
+		t.Fatalf("got address %s, want %s", got.GetAddress(), want.GetAddress())
+	}
+}
+
+func TestParseWalletRoundTrip(t *testing.T) {
+	w, err := NewWallet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := map[string]string{
+		"seed":     w.GetPrivateSeed(),
+		"full key": w.GetPrivateKey(),
+	}
+	for name, raw := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseWallet(raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertSameWallet(t, got, w)
+		})
+	}
+}
+
+func TestParsePEMRoundTrip(t *testing.T) {
+	w, err := NewWallet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs, err := w.MarshalPEM()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParsePEM(bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSameWallet(t, got, w)
+}
+
+func TestParseWalletRejectsInvalidKeys(t *testing.T) {
+	w, err := NewWallet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := NewWallet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mismatched := append(append([]byte(nil), w.PrivateKey.Seed()...), other.PublicKey...)
+	cases := map[string]string{
+		"31 byte seed":        base58.Encode(w.PrivateKey.Seed()[:31]),
+		"mismatched full key": base58.Encode(mismatched),
+	}
+	for name, raw := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseWallet(raw); !errors.Is(err, ErrInvalidPrivateKey) {
+				t.Fatalf("got error %v, want %v", err, ErrInvalidPrivateKey)
+			}
+		})
+	}
+}
+
+func TestParsePEMRejectsInvalidKeys(t *testing.T) {
+	eckey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecder, err := x509.MarshalPKCS8PrivateKey(eckey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := map[string][]byte{
+		"non ed25519 key": pem.EncodeToMemory(&pem.Block{Type: PEMBlockType, Bytes: ecder}),
+		"wrong pem type":  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}),
+	}
+	for name, bs := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParsePEM(bs); !errors.Is(err, ErrInvalidPrivateKey) {
+				t.Fatalf("got error %v, want %v", err, ErrInvalidPrivateKey)
+			}
+		})
+	}
+}